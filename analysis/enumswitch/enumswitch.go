@@ -0,0 +1,161 @@
+// Package enumswitch defines an Analyzer that reports non-exhaustive switches
+// over enums without an assert.UnknownEnum default case.
+//
+// # Analyzer enumswitch
+//
+// enumswitch: report non-exhaustive enum switches without assert.UnknownEnum
+//
+// An enum is a named type whose underlying type is an integer or a string
+// and whose package declares constants of that type. A switch over an enum
+// value must either have a case for every constant (accessible from the
+// switch package) or a default case calling assert.UnknownEnum (or
+// assert.UnknownEnumf), so new enum values can't be silently ignored:
+//
+//	switch color {
+//	case Red, Green:
+//		// ...
+//	default:
+//		assert.UnknownEnum(color)
+//	}
+package enumswitch
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const doc = `report non-exhaustive enum switches without assert.UnknownEnum
+
+A switch over a value of an enum type (a named integer or string type whose
+package declares constants of that type) must either handle every constant or
+have a default case calling assert.UnknownEnum.`
+
+const assertPkgPath = "github.com/negrel/assert"
+
+// Analyzer reports non-exhaustive switches over enums without an
+// assert.UnknownEnum default case.
+var Analyzer = &analysis.Analyzer{
+	Name:     "enumswitch",
+	Doc:      doc,
+	URL:      "https://pkg.go.dev/github.com/negrel/assert/analysis/enumswitch",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	inspect.Preorder([]ast.Node{(*ast.SwitchStmt)(nil)}, func(n ast.Node) {
+		stmt := n.(*ast.SwitchStmt)
+		if stmt.Tag == nil {
+			return
+		}
+
+		enum, ok := types.Unalias(pass.TypesInfo.TypeOf(stmt.Tag)).(*types.Named)
+		if !ok {
+			return
+		}
+		members := enumMembers(pass.Pkg, enum)
+		if len(members) == 0 {
+			return
+		}
+
+		covered := make(map[string]bool)
+		var dflt *ast.CaseClause
+		for _, clause := range stmt.Body.List {
+			clause := clause.(*ast.CaseClause)
+			if clause.List == nil {
+				dflt = clause
+			}
+			for _, expr := range clause.List {
+				if tv := pass.TypesInfo.Types[expr]; tv.Value != nil {
+					covered[tv.Value.ExactString()] = true
+				}
+			}
+		}
+
+		var missing []string
+		for _, c := range members {
+			if !covered[c.Val().ExactString()] {
+				missing = append(missing, c.Name())
+			}
+		}
+		if len(missing) == 0 {
+			return
+		}
+
+		enumName := types.TypeString(enum, types.RelativeTo(pass.Pkg))
+		switch {
+		case dflt == nil:
+			pass.Reportf(stmt.Pos(), "switch on %s is not exhaustive (missing %s) and has no default case calling assert.UnknownEnum",
+				enumName, strings.Join(missing, ", "))
+		case !callsUnknownEnum(pass, dflt):
+			pass.Reportf(dflt.Pos(), "switch on %s is not exhaustive (missing %s) and its default case doesn't call assert.UnknownEnum",
+				enumName, strings.Join(missing, ", "))
+		}
+	})
+
+	return nil, nil
+}
+
+// enumMembers returns the constants of the enum type declared by its package
+// and accessible from pkg. It returns nil if enum isn't an enum type.
+func enumMembers(pkg *types.Package, enum *types.Named) []*types.Const {
+	basic, ok := enum.Underlying().(*types.Basic)
+	if !ok || basic.Info()&(types.IsInteger|types.IsString) == 0 {
+		return nil
+	}
+
+	enumPkg := enum.Obj().Pkg()
+	if enumPkg == nil {
+		return nil
+	}
+
+	var members []*types.Const
+	scope := enumPkg.Scope()
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok || !types.Identical(c.Type(), enum) || c.Val().Kind() == constant.Unknown {
+			continue
+		}
+		if enumPkg != pkg && !c.Exported() {
+			continue
+		}
+		members = append(members, c)
+	}
+
+	return members
+}
+
+// callsUnknownEnum reports whether the body of clause contains a call to
+// assert.UnknownEnum or assert.UnknownEnumf, including calls nested in other
+// statements and expressions (e.g. "return assert.UnknownEnum(v)").
+func callsUnknownEnum(pass *analysis.Pass, clause *ast.CaseClause) bool {
+	found := false
+	for _, stmt := range clause.Body {
+		ast.Inspect(stmt, func(n ast.Node) bool {
+			if found {
+				return false
+			}
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+			if ok && fn.Pkg() != nil && fn.Pkg().Path() == assertPkgPath &&
+				(fn.Name() == "UnknownEnum" || fn.Name() == "UnknownEnumf") {
+				found = true
+			}
+			return !found
+		})
+	}
+
+	return found
+}
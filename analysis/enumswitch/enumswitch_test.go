@@ -0,0 +1,12 @@
+package enumswitch_test
+
+import (
+	"testing"
+
+	"github.com/negrel/assert/analysis/enumswitch"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), enumswitch.Analyzer, "a", "colors")
+}
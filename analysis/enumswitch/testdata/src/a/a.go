@@ -0,0 +1,78 @@
+package a
+
+import (
+	"colors"
+
+	"github.com/negrel/assert"
+)
+
+type Status string
+
+const (
+	Active   Status = "active"
+	Inactive Status = "inactive"
+)
+
+type notEnum int
+
+func status(s Status, c colors.Color, n notEnum, i int) {
+	switch s { // want `switch on Status is not exhaustive \(missing Inactive\) and has no default case calling assert.UnknownEnum`
+	case Active:
+	}
+
+	switch s {
+	case Active:
+	default: // want `switch on Status is not exhaustive \(missing Inactive\) and its default case doesn't call assert.UnknownEnum`
+		assert.Fail("unknown status")
+	}
+
+	switch s {
+	case "active":
+	default:
+		assert.UnknownEnumf(s, "unknown status %v", s)
+	}
+
+	switch s {
+	case Active, Inactive:
+	}
+
+	// Unexported colors.transparent can't be handled.
+	switch c {
+	case colors.Red, colors.Green, colors.Blue:
+	}
+
+	switch c { // want `switch on colors.Color is not exhaustive \(missing Blue\) and has no default case calling assert.UnknownEnum`
+	case colors.Red, colors.Green:
+	}
+
+	switch n {
+	case 1:
+	}
+
+	switch i {
+	case 1:
+	}
+
+	switch {
+	case s == Active:
+	}
+}
+
+func isActive(s Status) bool {
+	switch s {
+	case Active:
+		return true
+	default:
+		return assert.UnknownEnum(s)
+	}
+}
+
+func checkStatus(s Status) {
+	switch s {
+	case Active:
+	default:
+		if !assert.UnknownEnum(s) {
+			return
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package colors
+
+import "github.com/negrel/assert"
+
+type Color int
+
+const (
+	Red Color = iota
+	Green
+	Blue
+	transparent
+)
+
+// Crimson is an alias of Red.
+const Crimson = Red
+
+func Name(c Color) string {
+	switch c { // want `switch on Color is not exhaustive \(missing transparent\) and has no default case calling assert.UnknownEnum`
+	case Red:
+		return "red"
+	case Green:
+		return "green"
+	case Blue:
+		return "blue"
+	}
+
+	switch c {
+	case Crimson, Green, Blue, transparent:
+	}
+
+	return ""
+}
+
+func IsDark(c Color) bool {
+	switch c {
+	case Blue:
+		return true
+	case Red, Green:
+		return false
+	default:
+		assert.UnknownEnum(c)
+		return false
+	}
+}
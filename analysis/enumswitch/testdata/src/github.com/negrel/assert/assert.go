@@ -0,0 +1,5 @@
+package assert
+
+func Fail(failureMessage string, msgAndArgs ...interface{}) bool       { return true }
+func UnknownEnum(v interface{}, msgAndArgs ...interface{}) bool        { return true }
+func UnknownEnumf(v interface{}, msg string, args ...interface{}) bool { return true }
@@ -0,0 +1,342 @@
+// Package sideeffects defines an Analyzer that reports arguments of
+// assertions that have side effects.
+//
+// # Analyzer sideeffects
+//
+// sideeffects: report side effects in assertion arguments
+//
+// Assertions of github.com/negrel/assert are removed when the program is
+// compiled without the assert tag, but their arguments are still evaluated.
+// Code such as:
+//
+//	assert.NoError(f.Close())
+//	assert.True(q.Pop() != nil)
+//
+// behaves the same in both builds but reads as if the call could be removed
+// along with the assertion. This analyzer reports arguments of assertions
+// that contain function calls, channel receives, assignments or allocations.
+//
+// Calls to functions known to be pure (see the -allow flag) are not reported.
+// Checked operations such as assert.Add, whose result is used in both builds,
+// aren't assertions and their arguments are not inspected.
+// Function literals are not inspected unless they're called immediately, so
+// lazy forms such as assert.That(func() bool { ... }) are never reported.
+// Assertions within lazy function arguments are not reported either, unlike
+// those within the fast implementation of assert.SameResult, which is called
+// in both builds.
+package sideeffects
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const doc = `report side effects in assertion arguments
+
+Assertions of github.com/negrel/assert are removed when the program is
+compiled without the assert tag, but their arguments are still evaluated.
+This analyzer reports arguments of assertions that contain function calls,
+channel receives, assignments or allocations.`
+
+// AssertPkgPath is the import path of the assert package.
+const AssertPkgPath = "github.com/negrel/assert"
+
+// Analyzer reports side effects in arguments of assertions.
+var Analyzer = &analysis.Analyzer{
+	Name:     "sideeffects",
+	Doc:      doc,
+	URL:      "https://pkg.go.dev/github.com/negrel/assert/analysis/sideeffects",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// DefaultAllowlist contains functions that have no side effects and can be
+// called within assertion arguments. Entries are full names as returned by
+// [types.Func.FullName]. An entry ending with ".*" allows every function and
+// method of a package.
+var DefaultAllowlist = []string{
+	AssertPkgPath + ".ObjectsAreEqual",
+	AssertPkgPath + ".ObjectsAreEqualValues",
+	AssertPkgPath + ".ObjectsExportedFieldsAreEqual",
+	AssertPkgPath + ".CallerInfo",
+	AssertPkgPath + ".Diff",
+	AssertPkgPath + ".FormatValues",
+	AssertPkgPath + ".LabeledOutput",
+	AssertPkgPath + ".With",
+	"(error).Error",
+	"(fmt.Stringer).String",
+	"bytes.Compare",
+	"bytes.Contains",
+	"bytes.Equal",
+	"bytes.HasPrefix",
+	"bytes.HasSuffix",
+	"bytes.Index",
+	"errors.Is",
+	"math.*",
+	"reflect.DeepEqual",
+	"reflect.TypeOf",
+	"slices.Contains",
+	"slices.Equal",
+	"slices.Index",
+	"slices.IsSorted",
+	"sort.IntsAreSorted",
+	"sort.StringsAreSorted",
+	"strings.Contains",
+	"strings.ContainsRune",
+	"strings.Count",
+	"strings.EqualFold",
+	"strings.HasPrefix",
+	"strings.HasSuffix",
+	"strings.Index",
+	"strings.IndexByte",
+	"strings.LastIndex",
+	"unicode.*",
+	"unicode/utf8.*",
+}
+
+var allow string
+
+func init() {
+	Analyzer.Flags.StringVar(&allow, "allow", "",
+		"comma-separated list of additional pure functions (e.g. mypkg.Check,(*mypkg.T).Len,mypkg.*)")
+}
+
+// pureBuiltins are builtin functions without side effects nor allocations.
+var pureBuiltins = map[string]bool{
+	"len":     true,
+	"cap":     true,
+	"complex": true,
+	"real":    true,
+	"imag":    true,
+	"min":     true,
+	"max":     true,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	allowlist := make(map[string]bool)
+	for _, fn := range DefaultAllowlist {
+		allowlist[fn] = true
+	}
+	for _, fn := range strings.Split(allow, ",") {
+		if fn = strings.TrimSpace(fn); fn != "" {
+			allowlist[fn] = true
+		}
+	}
+
+	c := checker{pass: pass, allowlist: allowlist}
+	inspect.WithStack([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		call := n.(*ast.CallExpr)
+		fn := c.assertion(call)
+		if fn == nil || c.isAllowed(fn) {
+			return true
+		}
+
+		// Assertions nested in a lazy argument (e.g. of assert.Lazy) are
+		// removed along with it.
+		for i := len(stack) - 2; i > 0; i-- {
+			if lit, ok := stack[i].(*ast.FuncLit); ok && c.isLazyArg(stack[i-1], lit) {
+				return true
+			}
+		}
+
+		for _, arg := range call.Args {
+			c.checkExpr(fn, arg)
+		}
+		return true
+	})
+
+	return nil, nil
+}
+
+type checker struct {
+	pass      *analysis.Pass
+	allowlist map[string]bool
+}
+
+// checkedOperations are functions of the assert package that perform an
+// operation whose result is used in both builds and only check it when
+// assertions are enabled. Their arguments are never removed.
+var checkedOperations = map[string]bool{
+	"Add":     true,
+	"Sub":     true,
+	"Mul":     true,
+	"Convert": true,
+	"Index":   true,
+	"Slice":   true,
+	"As":      true,
+	"Deref":   true,
+}
+
+// assertion returns the function of the assert package called by call, if
+// any. Checked operations (see checkedOperations) aren't assertions.
+func (c *checker) assertion(call *ast.CallExpr) *types.Func {
+	fn, ok := typeutil.Callee(c.pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != AssertPkgPath || checkedOperations[fn.Name()] {
+		return nil
+	}
+	return fn
+}
+
+// lazyParams contains the index of the function parameter of assertions that
+// is only called when assertions are enabled. Other function arguments, such
+// as the fast implementation of assert.SameResult, are called in both builds.
+var lazyParams = map[string]int{
+	"That":        0,
+	"Thatf":       0,
+	"Lazy":        0,
+	"Ensures":     0,
+	"Ensuresf":    0,
+	"Debug":       0,
+	"SameResult":  1,
+	"SameResultf": 1,
+}
+
+// isLazyArg reports whether lit is the lazy argument of assertion call n (see
+// lazyParams).
+func (c *checker) isLazyArg(n ast.Node, lit *ast.FuncLit) bool {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	fn := c.assertion(call)
+	if fn == nil {
+		return false
+	}
+	i, ok := lazyParams[fn.Name()]
+	return ok && i < len(call.Args) && astutil.Unparen(call.Args[i]) == lit
+}
+
+// isAllowed reports whether fn is a pure function.
+func (c *checker) isAllowed(fn *types.Func) bool {
+	if c.allowlist[fn.FullName()] {
+		return true
+	}
+	if fn.Pkg() != nil && c.allowlist[fn.Pkg().Path()+".*"] {
+		return true
+	}
+	return false
+}
+
+// checkExpr reports side effects in expression arg of assertion fn.
+func (c *checker) checkExpr(fn *types.Func, arg ast.Expr) {
+	ast.Inspect(arg, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			// Function literals are evaluated lazily (e.g. assert.That).
+			return false
+
+		case *ast.UnaryExpr:
+			if n.Op == token.ARROW {
+				c.report(fn, n, "channel receive")
+			}
+			if _, ok := n.X.(*ast.CompositeLit); ok && n.Op == token.AND {
+				c.report(fn, n, "allocation")
+				return false
+			}
+
+		case *ast.CompositeLit:
+			switch c.pass.TypesInfo.TypeOf(n).Underlying().(type) {
+			case *types.Slice, *types.Map:
+				c.report(fn, n, "allocation")
+				return false
+			}
+
+		case *ast.CallExpr:
+			return c.checkCall(fn, n)
+		}
+
+		return true
+	})
+}
+
+// checkCall reports side effects of call within arguments of assertion fn.
+// It returns whether arguments of call must be inspected.
+func (c *checker) checkCall(fn *types.Func, call *ast.CallExpr) bool {
+	info := c.pass.TypesInfo
+
+	// Type conversion.
+	if tv, ok := info.Types[call.Fun]; ok && tv.IsType() {
+		return true
+	}
+
+	// Immediately invoked function literal.
+	if lit, ok := astutil.Unparen(call.Fun).(*ast.FuncLit); ok {
+		c.checkFuncBody(fn, lit.Body)
+		return true
+	}
+
+	switch callee := typeutil.Callee(info, call).(type) {
+	case *types.Builtin:
+		name := callee.Name()
+		switch {
+		case pureBuiltins[name]:
+		case name == "append" || name == "make" || name == "new":
+			c.report(fn, call, "allocation")
+		default:
+			c.report(fn, call, "call to builtin "+name)
+		}
+		return true
+
+	case *types.Func:
+		if c.isAllowed(callee) {
+			return true
+		}
+		c.report(fn, call, "call to "+callName(callee))
+		return true
+	}
+
+	c.report(fn, call, "function call")
+	return true
+}
+
+// checkFuncBody reports assignments, sends and side effects of body of an
+// immediately invoked function literal.
+func (c *checker) checkFuncBody(fn *types.Func, body *ast.BlockStmt) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if n.Tok != token.DEFINE {
+				c.report(fn, n, "assignment")
+			}
+		case *ast.IncDecStmt:
+			c.report(fn, n, "assignment")
+		case *ast.SendStmt:
+			c.report(fn, n, "channel send")
+		case ast.Expr:
+			c.checkExpr(fn, n)
+			return false
+		}
+		return true
+	})
+}
+
+func (c *checker) report(fn *types.Func, n ast.Node, what string) {
+	c.pass.ReportRangef(n, "argument of assert.%s has side effects: %s", fn.Name(), what)
+}
+
+// callName returns a short name of fn suitable for diagnostics
+// (e.g. os.Open or (*os.File).Close).
+func callName(fn *types.Func) string {
+	qualifier := func(p *types.Package) string { return p.Name() }
+	if sig, ok := fn.Type().(*types.Signature); ok && sig.Recv() != nil {
+		return "(" + types.TypeString(sig.Recv().Type(), qualifier) + ")." + fn.Name()
+	}
+	if fn.Pkg() == nil {
+		return fn.Name()
+	}
+	return fn.Pkg().Name() + "." + fn.Name()
+}
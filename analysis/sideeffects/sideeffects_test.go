@@ -0,0 +1,17 @@
+package sideeffects_test
+
+import (
+	"testing"
+
+	"github.com/negrel/assert/analysis/sideeffects"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	if err := sideeffects.Analyzer.Flags.Set("allow", "(*a.queue).Len"); err != nil {
+		t.Fatal(err)
+	}
+	defer sideeffects.Analyzer.Flags.Set("allow", "")
+
+	analysistest.Run(t, analysistest.TestData(), sideeffects.Analyzer, "a")
+}
@@ -0,0 +1,76 @@
+package a
+
+import (
+	"os"
+	"strings"
+
+	"github.com/negrel/assert"
+)
+
+type queue struct{ items []int }
+
+func (q *queue) Pop() *int { return nil }
+
+func (q *queue) Len() int { return len(q.items) }
+
+func pure(x int) int { return x }
+
+func f(file *os.File, q *queue, ch chan int, s string, x int) {
+	assert.NoError(file.Close())  // want `argument of assert.NoError has side effects: call to \(\*os.File\).Close`
+	assert.True(q.Pop() != nil)   // want `argument of assert.True has side effects: call to \(\*a.queue\).Pop`
+	assert.True(<-ch > 0)         // want `argument of assert.True has side effects: channel receive`
+	assert.Equal(&queue{}, q)     // want `argument of assert.Equal has side effects: allocation`
+	assert.Len(make([]int, x), x) // want `argument of assert.Len has side effects: allocation`
+	assert.Len([]int{1, 2}, 2)    // want `argument of assert.Len has side effects: allocation`
+	assert.True(func() bool {
+		x++ // want `argument of assert.True has side effects: assignment`
+		return true
+	}())
+	assert.True(pure(x) == x) // want `argument of assert.True has side effects: call to a.pure`
+
+	// Pure expressions.
+	assert.True(len(s) > 0 && x < 10)
+	assert.True(strings.HasPrefix(s, "foo"))
+	assert.Equal(queue{}, *q)
+	assert.Equal(int64(x), int64(1))
+	assert.True(q.Len() == 0) // allowed using -allow flag.
+
+	// Lazy forms.
+	assert.That(func() bool { return q.Pop() != nil })
+	assert.Lazy(func() {
+		assert.NoError(file.Close())
+	})
+	assert.True(true, func() string { return s + s })
+	assert.Debug(func() {
+		assert.NoError(file.Close())
+	})
+	defer assert.Ensures(func() bool {
+		return assert.True(q.Pop() == nil)
+	})
+
+	// Only the reference implementation of SameResult is lazy.
+	x = assert.SameResult(
+		func() int {
+			assert.True(q.Pop() != nil) // want `argument of assert.True has side effects: call to \(\*a.queue\).Pop`
+			return x
+		},
+		func() int {
+			assert.True(q.Pop() != nil)
+			return x
+		},
+	)
+
+	// Not an assertion.
+	_ = assert.ObjectsAreEqual(q.Pop(), nil)
+
+	// Checked operations are executed in both builds.
+	x = assert.Add(x, pure(x))
+	x = assert.Sub(x, pure(x))
+	x = assert.Mul(x, pure(x))
+	x = assert.Convert[int](int32(pure(x)))
+	x = assert.Index(q.items, pure(x))
+	_ = assert.Slice(q.items, 0, pure(x))
+	x = assert.As[int](any(pure(x)))
+	x = assert.Deref(q.Pop())
+	assert.InBounds(pure(x), len(q.items)) // want `argument of assert.InBounds has side effects: call to a.pure`
+}
@@ -0,0 +1,24 @@
+package assert
+
+func True(value bool, msgAndArgs ...interface{}) bool                    { return true }
+func NoError(err error, msgAndArgs ...interface{}) bool                  { return true }
+func Equal(expected, actual interface{}, msgAndArgs ...interface{}) bool { return true }
+func Len(object interface{}, length int, msgAndArgs ...interface{}) bool { return true }
+func That(cond func() bool, msgAndArgs ...interface{}) bool              { return true }
+func Lazy(f func())                                                      {}
+func Debug(f func())                                                     {}
+func Ensures(cond func() bool, msgAndArgs ...interface{}) bool           { return true }
+func SameResult[T any](fast, reference func() T, msgAndArgs ...interface{}) T {
+	return fast()
+}
+func ObjectsAreEqual(expected, actual interface{}) bool { return true }
+
+func Add[T ~int](a, b T) T                                   { return a + b }
+func Sub[T ~int](a, b T) T                                   { return a - b }
+func Mul[T ~int](a, b T) T                                   { return a * b }
+func Convert[To, From ~int | ~int32](v From) To              { return To(v) }
+func Index[S ~[]E, E any](s S, i int) E                      { return s[i] }
+func Slice[S ~[]E, E any](s S, lo, hi int) S                 { return s[lo:hi] }
+func InBounds(i, length int, msgAndArgs ...interface{}) bool { return true }
+func As[T any](v any) T                                      { return v.(T) }
+func Deref[T any](p *T) T                                    { return *p }
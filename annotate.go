@@ -0,0 +1,106 @@
+//go:build assert
+
+package assert
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// With returns fields built from key/value pairs. When passed among the
+// msgAndArgs of an assertion, they are shown as labeled sections of its
+// failure report and stored in [AssertionError.Fields]:
+//
+//	assert.Equal(a, b, assert.With("tenant", id, "shard", s))
+//	assert.Equal(a, b, assert.With("tenant", id), "error message %s", "formatted")
+//
+// A value without key is labeled "!BADKEY".
+func With(kv ...interface{}) []Field {
+	fields := make([]Field, 0, (len(kv)+1)/2)
+	for i := 0; i < len(kv); i += 2 {
+		if i+1 == len(kv) {
+			fields = append(fields, Field{"!BADKEY", kv[i]})
+			break
+		}
+		fields = append(fields, Field{fmt.Sprint(kv[i]), kv[i+1]})
+	}
+
+	return fields
+}
+
+// annotations contains fields added with Annotate by goroutine ID.
+var annotations struct {
+	sync.Mutex
+	// active is the number of goroutines with annotations. It avoids
+	// retrieving the goroutine ID of failed assertions when there is none.
+	active atomic.Int64
+	fields map[int64][]Field
+}
+
+// Annotate adds fields built from key/value pairs (see [With]) to the
+// failure reports of assertions made by the calling goroutine until the
+// returned function is called. Nested annotations must be removed in reverse
+// order, which is the case when the returned function is deferred:
+//
+//	func (s *Server) handle(req *Request) {
+//		defer assert.Annotate("tenant", req.Tenant, "request", req.ID)()
+//		// ...
+//	}
+func Annotate(kv ...interface{}) (done func()) {
+	fields := With(kv...)
+	gid := goid()
+
+	annotations.Lock()
+	defer annotations.Unlock()
+
+	if annotations.fields == nil {
+		annotations.fields = make(map[int64][]Field)
+	}
+	prev := annotations.fields[gid]
+	if len(prev) == 0 {
+		annotations.active.Add(1)
+	}
+	annotations.fields[gid] = append(prev[:len(prev):len(prev)], fields...)
+
+	return func() {
+		annotations.Lock()
+		defer annotations.Unlock()
+
+		if len(prev) > 0 {
+			annotations.fields[gid] = prev
+		} else if _, ok := annotations.fields[gid]; ok {
+			delete(annotations.fields, gid)
+			annotations.active.Add(-1)
+		}
+	}
+}
+
+// goroutineAnnotations returns the fields added with Annotate by the calling
+// goroutine.
+func goroutineAnnotations() []Field {
+	if annotations.active.Load() == 0 {
+		return nil
+	}
+
+	gid := goid()
+	annotations.Lock()
+	defer annotations.Unlock()
+	return annotations.fields[gid]
+}
+
+// splitFields returns msgAndArgs without the fields returned by [With] and
+// those fields.
+func splitFields(msgAndArgs []interface{}) ([]interface{}, []Field) {
+	var fields []Field
+	args := msgAndArgs[:0:0]
+	for _, arg := range msgAndArgs {
+		if f, ok := arg.([]Field); ok {
+			fields = append(fields, f...)
+			continue
+		}
+		args = append(args, arg)
+	}
+
+	return args, fields
+}
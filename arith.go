@@ -0,0 +1,81 @@
+//go:build assert
+
+package assert
+
+import "fmt"
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// isSigned reports whether T is a signed integer type.
+func isSigned[T Integer]() bool {
+	var zero T
+	return zero-1 < 0
+}
+
+// failOverflow reports an overflow of the operation a op b.
+func failOverflow[T Integer](a T, op string, b T) {
+	Fail(fmt.Sprintf("Integer overflow: %v %s %v overflows %T", a, op, b, a))
+}
+
+// Add returns a + b. It asserts that the addition doesn't overflow.
+//
+//	total := assert.Add(total, n)
+func Add[T Integer](a, b T) T {
+	c := a + b
+	if (c < a) != (isSigned[T]() && b < 0) {
+		failOverflow(a, "+", b)
+	}
+
+	return c
+}
+
+// Sub returns a - b. It asserts that the subtraction doesn't overflow (or
+// underflow for unsigned integers).
+//
+//	remaining := assert.Sub(capacity, used)
+func Sub[T Integer](a, b T) T {
+	c := a - b
+	if (c > a) != (isSigned[T]() && b < 0) {
+		failOverflow(a, "-", b)
+	}
+
+	return c
+}
+
+// Mul returns a * b. It asserts that the multiplication doesn't overflow.
+//
+//	size := assert.Mul(count, elemSize)
+func Mul[T Integer](a, b T) T {
+	if a == 0 || b == 0 {
+		return 0
+	}
+
+	c := a * b
+	overflow := c/b != a
+	if isSigned[T]() {
+		// Minimum value times -1 overflows but c/b == a.
+		overflow = overflow || (a == -a && b == b-b-1) || (b == -b && a == a-a-1)
+	}
+	if overflow {
+		failOverflow(a, "*", b)
+	}
+
+	return c
+}
+
+// Convert returns v converted to type To. It asserts that the conversion
+// doesn't truncate v nor change its sign.
+//
+//	n := assert.Convert[int32](len(s))
+func Convert[To, From Integer](v From) To {
+	c := To(v)
+	if From(c) != v || (c < 0) != (v < 0) {
+		Fail(fmt.Sprintf("Integer conversion of %v from %T to %T changes its value to %v", v, v, c, c))
+	}
+
+	return c
+}
@@ -0,0 +1,47 @@
+//go:build assert
+
+package assert
+
+import "fmt"
+
+// Index returns s[i]. It asserts that i is within the bounds of s and reports
+// the index, length and capacity of s otherwise.
+//
+//	day := assert.Index(days, i)
+func Index[S ~[]E, E any](s S, i int) E {
+	if i < 0 || i >= len(s) {
+		Fail(fmt.Sprintf("Index out of range: index %d, len %d, cap %d", i, len(s), cap(s)))
+	}
+
+	return s[i]
+}
+
+// Slice returns s[lo:hi]. It asserts that 0 <= lo <= hi <= cap(s) and reports
+// the bounds, length and capacity of s otherwise.
+//
+//	weekdays := assert.Slice(days, 0, 5)
+func Slice[S ~[]E, E any](s S, lo, hi int) S {
+	if lo < 0 || lo > hi || hi > cap(s) {
+		Fail(fmt.Sprintf("Slice bounds out of range: [%d:%d], len %d, cap %d", lo, hi, len(s), cap(s)))
+	}
+
+	return s[lo:hi]
+}
+
+// InBounds asserts that 0 <= i < length.
+//
+//	assert.InBounds(i, len(days))
+func InBounds(i, length int, msgAndArgs ...interface{}) bool {
+	if i < 0 || i >= length {
+		return Fail(fmt.Sprintf("Index out of range: index %d, len %d", i, length), msgAndArgs...)
+	}
+
+	return true
+}
+
+// InBoundsf asserts that 0 <= i < length.
+//
+//	assert.InBoundsf(i, len(days), "error message %s", "formatted")
+func InBoundsf(i, length int, msg string, args ...interface{}) bool {
+	return InBounds(i, length, append([]interface{}{msg}, args...)...)
+}
@@ -0,0 +1,510 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// generator translates testify files.
+type generator struct {
+	// source identifies the translated testify package in generated files
+	// header.
+	source string
+	// overrides contains keys of hand-written declarations that replace
+	// upstream ones.
+	overrides map[string]bool
+
+	fset    *token.FileSet
+	imports map[string]string // import path -> package name.
+	formats []*ast.FuncDecl   // assertions with a *f variant.
+}
+
+func newGenerator(source string, overrides map[string]bool) *generator {
+	return &generator{
+		source:    source,
+		overrides: overrides,
+		fset:      token.NewFileSet(),
+		imports:   make(map[string]string),
+	}
+}
+
+// generate translates the given testify files and returns generated files
+// sources indexed by their name.
+func (g *generator) generate(paths []string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+
+	for _, fpath := range paths {
+		f, err := parser.ParseFile(g.fset, fpath, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		cmap := ast.NewCommentMap(g.fset, f, f.Comments)
+
+		if err := g.translate(f); err != nil {
+			return nil, err
+		}
+
+		src, err := g.print(f, cmap, "assert")
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", fpath, err)
+		}
+
+		if err := g.prod(f); err != nil {
+			return nil, err
+		}
+		prodSrc, err := g.print(f, cmap, "!assert")
+		if err != nil {
+			return nil, fmt.Errorf("prod_%v: %w", fpath, err)
+		}
+
+		name := filepath.Base(fpath)
+		files[name] = src
+		files["prod_"+name] = prodSrc
+	}
+
+	src, prodSrc, err := g.formatVariants()
+	if err != nil {
+		return nil, fmt.Errorf("assertion_format.go: %w", err)
+	}
+	files["assertion_format.go"] = src
+	files["prod_assertion_format.go"] = prodSrc
+
+	return files, nil
+}
+
+// translate removes TestingT parameters and their usage from f as well as
+// declarations overridden by hand-written files.
+func (g *generator) translate(f *ast.File) error {
+	if err := g.translateImports(f); err != nil {
+		return err
+	}
+
+	// Remove TestingT parameters from function signatures.
+	removed := make(map[*ast.Object]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if ft, ok := n.(*ast.FuncType); ok && ft.Params != nil {
+			params := ft.Params.List[:0]
+			for _, field := range ft.Params.List {
+				if id, ok := field.Type.(*ast.Ident); ok && id.Name == "TestingT" {
+					for _, name := range field.Names {
+						removed[name.Obj] = true
+					}
+					continue
+				}
+				params = append(params, field)
+			}
+			ft.Params.List = params
+		}
+		return true
+	})
+
+	isT := func(expr ast.Expr) bool {
+		id, ok := expr.(*ast.Ident)
+		return ok && id.Obj != nil && removed[id.Obj]
+	}
+
+	// Remove TestingT usages.
+	astutil.Apply(f, func(c *astutil.Cursor) bool {
+		switch n := c.Node().(type) {
+		case *ast.IfStmt:
+			// if h, ok := t.(tHelper); ok { ... }
+			if init, ok := n.Init.(*ast.AssignStmt); ok && len(init.Rhs) == 1 {
+				if ta, ok := init.Rhs[0].(*ast.TypeAssertExpr); ok && isT(ta.X) {
+					c.Delete()
+					return false
+				}
+			}
+
+		case *ast.CallExpr:
+			// t.Errorf(format, args...) -> Fail(fmt.Sprintf(format, args...))
+			if sel, ok := n.Fun.(*ast.SelectorExpr); ok && isT(sel.X) && sel.Sel.Name == "Errorf" {
+				c.Replace(&ast.CallExpr{
+					Fun: ast.NewIdent("Fail"),
+					Args: []ast.Expr{&ast.CallExpr{
+						Fun:  &ast.SelectorExpr{X: ast.NewIdent("fmt"), Sel: ast.NewIdent("Sprintf")},
+						Args: n.Args,
+					}},
+				})
+				return true
+			}
+
+			// Fail(t, ...) -> Fail(...)
+			if len(n.Args) > 0 && isT(n.Args[0]) {
+				n.Args = n.Args[1:]
+			}
+		}
+
+		return true
+	}, nil)
+
+	var err error
+	ast.Inspect(f, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && isT(id) && err == nil {
+			err = fmt.Errorf("%v: unsupported use of TestingT parameter %q", g.fset.Position(id.Pos()), id.Name)
+		}
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Remove "t, " from examples.
+	for _, cg := range f.Comments {
+		for _, c := range cg.List {
+			c.Text = strings.ReplaceAll(c.Text, "(t, ", "(")
+		}
+	}
+
+	f.Decls = filterDecls(f.Decls, func(key string) bool {
+		return !strings.HasPrefix(key, "YAML")
+	})
+
+	// Collect assertions with a *f variant before removing overridden
+	// declarations.
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && hasFormatVariant(fn) {
+			g.formats = append(g.formats, fn)
+		}
+	}
+
+	f.Decls = filterDecls(f.Decls, func(key string) bool {
+		return !g.overrides[key]
+	})
+
+	return nil
+}
+
+// translateImports records imports of f and removes testify's yaml package.
+// It returns an error if f imports any other testify package.
+func (g *generator) translateImports(f *ast.File) error {
+	for _, spec := range f.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return err
+		}
+
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+
+		switch {
+		case path == testifyModule+"/assert/yaml":
+			// Only used by YAML* assertions that are removed.
+			astutil.DeleteImport(g.fset, f, path)
+
+			var err error
+			ast.Inspect(f, func(n ast.Node) bool {
+				fn, ok := n.(*ast.FuncDecl)
+				if ok && strings.HasPrefix(fn.Name.Name, "YAML") {
+					return false
+				}
+				if sel, ok := n.(*ast.SelectorExpr); ok && err == nil {
+					if id, ok := sel.X.(*ast.Ident); ok && id.Obj == nil && id.Name == name {
+						err = fmt.Errorf("%v: unsupported use of package %q", g.fset.Position(id.Pos()), path)
+					}
+				}
+				return err == nil
+			})
+			if err != nil {
+				return err
+			}
+
+		case strings.HasPrefix(path, testifyModule):
+			return fmt.Errorf("%v: unsupported import of %q", g.fset.Position(spec.Pos()), path)
+
+		default:
+			g.imports[path] = name
+		}
+	}
+
+	return nil
+}
+
+// prod transforms translated file f to its !assert build version: unexported
+// declarations are removed and exported functions become stubs.
+func (g *generator) prod(f *ast.File) error {
+	types := make(map[string]bool)
+	for _, decl := range f.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.TYPE {
+			for _, key := range declKeys(gen) {
+				types[key] = true
+			}
+		}
+	}
+
+	// Unexported types used by exported declarations are kept.
+	used := make(map[string]bool)
+	keep := func(key string) bool {
+		name := key[strings.LastIndex(key, ".")+1:]
+		recv := strings.TrimSuffix(key, name)
+		return ast.IsExported(name) && (recv == "" || ast.IsExported(strings.TrimSuffix(recv, "."))) ||
+			used[key]
+	}
+	for {
+		n := len(used)
+		for _, decl := range f.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok {
+				decl = &ast.FuncDecl{Recv: fn.Recv, Name: fn.Name, Type: fn.Type}
+			}
+			for _, key := range declKeys(decl) {
+				if !keep(key) {
+					continue
+				}
+				ast.Inspect(decl, func(n ast.Node) bool {
+					if id, ok := n.(*ast.Ident); ok && types[id.Name] {
+						used[id.Name] = true
+					}
+					return true
+				})
+			}
+		}
+		if len(used) == n {
+			break
+		}
+	}
+
+	f.Decls = filterDecls(f.Decls, keep)
+
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+
+		body := &ast.BlockStmt{Lbrace: fn.Body.Lbrace, Rbrace: fn.Body.Lbrace}
+		if fn.Type.Results != nil {
+			ret := &ast.ReturnStmt{}
+			for _, field := range fn.Type.Results.List {
+				value, err := stubValue(field.Type)
+				if err != nil {
+					return fmt.Errorf("%v: %w", g.fset.Position(field.Pos()), err)
+				}
+				for i := 0; i < max(1, len(field.Names)); i++ {
+					ret.Results = append(ret.Results, value)
+				}
+			}
+			body.List = []ast.Stmt{ret}
+		}
+		fn.Body = body
+	}
+
+	return nil
+}
+
+// stubValue returns the value returned by stubs for results of type typ.
+// Assertions always succeed when they're disabled.
+func stubValue(typ ast.Expr) (ast.Expr, error) {
+	if id, ok := typ.(*ast.Ident); ok {
+		switch id.Name {
+		case "bool":
+			return ast.NewIdent("true"), nil
+		case "string":
+			return &ast.BasicLit{Kind: token.STRING, Value: `""`}, nil
+		case "error":
+			return ast.NewIdent("nil"), nil
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported result type %T in stub", typ)
+}
+
+// filterDecls returns decls whose key satisfies keep. Specs of declaration
+// blocks are filtered individually.
+func filterDecls(decls []ast.Decl, keep func(key string) bool) []ast.Decl {
+	result := decls[:0]
+	for _, decl := range decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if !keep(funcKey(decl)) {
+				continue
+			}
+
+		case *ast.GenDecl:
+			if decl.Tok == token.IMPORT {
+				break
+			}
+
+			specs := decl.Specs[:0]
+			for _, spec := range decl.Specs {
+				for _, key := range declKeys(&ast.GenDecl{Specs: []ast.Spec{spec}}) {
+					if keep(key) {
+						specs = append(specs, spec)
+						break
+					}
+				}
+			}
+			decl.Specs = specs
+			if len(specs) == 0 {
+				continue
+			}
+		}
+
+		result = append(result, decl)
+	}
+
+	return result
+}
+
+// print formats f with the given build constraint and generated file header.
+func (g *generator) print(f *ast.File, cmap ast.CommentMap, constraint string) ([]byte, error) {
+	f.Comments = cmap.Filter(f).Comments()
+
+	// Remove go:generate directives.
+	comments := f.Comments[:0]
+	for _, cg := range f.Comments {
+		if !strings.HasPrefix(cg.List[0].Text, "//go:generate") {
+			comments = append(comments, cg)
+		}
+	}
+	f.Comments = comments
+
+	removeUnusedImports(g.fset, f)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by assertgen from %v; DO NOT EDIT.\n\n", g.source)
+	fmt.Fprintf(&buf, "//go:build %v\n\n", constraint)
+	if err := format.Node(&buf, g.fset, f); err != nil {
+		return nil, err
+	}
+
+	// Removed statements may leave an empty line at the beginning of blocks.
+	src := emptyLineAfterBraceRegexp.ReplaceAll(buf.Bytes(), []byte("{\n"))
+
+	return format.Source(src)
+}
+
+var emptyLineAfterBraceRegexp = regexp.MustCompile(`\{\n\n+`)
+
+func removeUnusedImports(fset *token.FileSet, f *ast.File) {
+	// DeleteNamedImport modifies f.Imports.
+	imports := append([]*ast.ImportSpec(nil), f.Imports...)
+	for _, spec := range imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		if !astutil.UsesImport(f, path) {
+			name := ""
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			astutil.DeleteNamedImport(fset, f, name, path)
+		}
+	}
+}
+
+// hasFormatVariant reports whether fn is an assertion with a trailing
+// msgAndArgs parameter.
+func hasFormatVariant(fn *ast.FuncDecl) bool {
+	if fn.Recv != nil || !fn.Name.IsExported() || fn.Type.Params == nil {
+		return false
+	}
+
+	params := fn.Type.Params.List
+	if len(params) == 0 {
+		return false
+	}
+	last := params[len(params)-1]
+
+	return len(last.Names) == 1 && last.Names[0].Name == "msgAndArgs"
+}
+
+// formatCommentRegexp matches examples of the *f variant in doc comments.
+func formatCommentRegexp(name string) *regexp.Regexp {
+	return regexp.MustCompile(regexp.QuoteMeta(name) + `\(((\(\)|[^\n])+)\)`)
+}
+
+// formatVariants returns assert and prod sources of the *f variants of
+// assertions.
+func (g *generator) formatVariants() ([]byte, []byte, error) {
+	sort.Slice(g.formats, func(i, j int) bool {
+		return g.formats[i].Name.Name < g.formats[j].Name.Name
+	})
+
+	var buf, prodBuf bytes.Buffer
+	for _, b := range []*bytes.Buffer{&buf, &prodBuf} {
+		fmt.Fprintf(b, "// Code generated by assertgen from %v; DO NOT EDIT.\n\n", g.source)
+	}
+	fmt.Fprint(&buf, "//go:build assert\n\npackage assert\n\n")
+	fmt.Fprint(&prodBuf, "//go:build !assert\n\npackage assert\n\n")
+
+	for _, fn := range g.formats {
+		name := fn.Name.Name
+		fname := name + "f"
+		if g.overrides[fname] {
+			continue
+		}
+
+		var params, forward []string
+		fields := fn.Type.Params.List
+		for _, field := range fields[:len(fields)-1] {
+			var typ bytes.Buffer
+			if err := format.Node(&typ, g.fset, field.Type); err != nil {
+				return nil, nil, err
+			}
+			if _, ok := field.Type.(*ast.Ellipsis); ok {
+				return nil, nil, fmt.Errorf("%v: unsupported variadic parameter", g.fset.Position(field.Pos()))
+			}
+			for _, id := range field.Names {
+				params = append(params, id.Name+" "+typ.String())
+				forward = append(forward, id.Name)
+			}
+		}
+		params = append(params, "msg string", "args ...interface{}")
+		forward = append(forward, "append([]interface{}{msg}, args...)...")
+
+		var doc string
+		if fn.Doc != nil {
+			for _, c := range fn.Doc.List {
+				doc += c.Text + "\n"
+			}
+			doc = strings.ReplaceAll(doc, name, fname)
+			doc = formatCommentRegexp(fname).ReplaceAllString(doc, fname+`($1, "error message %s", "formatted")`)
+		}
+
+		signature := fmt.Sprintf("func %s(%s) bool", fname, strings.Join(params, ", "))
+		fmt.Fprintf(&buf, "%s%s {\n\treturn %s(%s)\n}\n\n", doc, signature, name, strings.Join(forward, ", "))
+		fmt.Fprintf(&prodBuf, "%s%s { return true }\n\n", doc, signature)
+	}
+
+	src, err := g.addImports(buf.Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+	prodSrc, err := g.addImports(prodBuf.Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return src, prodSrc, nil
+}
+
+// addImports adds imports of upstream packages used by src.
+func (g *generator) addImports(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	for path := range g.imports {
+		astutil.AddImport(fset, f, path)
+	}
+	removeUnusedImports(fset, f)
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, f); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
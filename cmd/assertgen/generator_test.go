@@ -0,0 +1,119 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeUpstream(t *testing.T, src string) string {
+	t.Helper()
+
+	fpath := filepath.Join(t.TempDir(), "assertions.go")
+	if err := os.WriteFile(fpath, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return fpath
+}
+
+func TestGenerate(t *testing.T) {
+	fpath := writeUpstream(t, `package assert
+
+import "fmt"
+
+// True asserts that the specified value is true.
+//
+//	assert.True(t, myBool)
+func True(t TestingT, value bool, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if !value {
+		return Fail(t, "Should be true", msgAndArgs...)
+	}
+	return true
+}
+
+// Fail reports a failure.
+func Fail(t TestingT, failureMessage string, msgAndArgs ...interface{}) bool {
+	t.Errorf("%s", failureMessage)
+	return false
+}
+
+func helper() string { return fmt.Sprint() }
+`)
+
+	files, err := newGenerator("test", map[string]bool{"Fail": true}).generate([]string{fpath})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src := string(files["assertions.go"])
+	for _, want := range []string{
+		"// Code generated by assertgen from test; DO NOT EDIT.",
+		"//go:build assert",
+		"//	assert.True(myBool)",
+		"func True(value bool, msgAndArgs ...interface{}) bool {\n\tif !value {",
+		`return Fail("Should be true", msgAndArgs...)`,
+		"func helper() string",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("assertions.go doesn't contain %q:\n%s", want, src)
+		}
+	}
+	if strings.Contains(src, "func Fail(") {
+		t.Errorf("assertions.go contains overridden Fail function:\n%s", src)
+	}
+
+	prodSrc := string(files["prod_assertions.go"])
+	for _, want := range []string{
+		"//go:build !assert",
+		"func True(value bool, msgAndArgs ...interface{}) bool { return true }",
+	} {
+		if !strings.Contains(prodSrc, want) {
+			t.Errorf("prod_assertions.go doesn't contain %q:\n%s", want, prodSrc)
+		}
+	}
+	for _, unwanted := range []string{"helper", "import"} {
+		if strings.Contains(prodSrc, unwanted) {
+			t.Errorf("prod_assertions.go contains %q:\n%s", unwanted, prodSrc)
+		}
+	}
+
+	formatSrc := string(files["assertion_format.go"])
+	if !strings.Contains(formatSrc, "func Truef(value bool, msg string, args ...interface{}) bool {\n\treturn True(value, append([]interface{}{msg}, args...)...)") {
+		t.Errorf("assertion_format.go doesn't contain Truef variant:\n%s", formatSrc)
+	}
+}
+
+func TestGenerateUnsupportedTestingTUsage(t *testing.T) {
+	fpath := writeUpstream(t, `package assert
+
+func Cleanup(t TestingT, msgAndArgs ...interface{}) bool {
+	t.Cleanup(func() {})
+	return true
+}
+`)
+
+	_, err := newGenerator("test", nil).generate([]string{fpath})
+	if err == nil || !strings.Contains(err.Error(), `unsupported use of TestingT parameter "t"`) {
+		t.Fatalf("expected unsupported TestingT usage error, got %v", err)
+	}
+}
+
+func TestGenerateUnsupportedImport(t *testing.T) {
+	fpath := writeUpstream(t, `package assert
+
+import "github.com/stretchr/testify/assert/internal"
+
+func True(t TestingT, value bool, msgAndArgs ...interface{}) bool {
+	return internal.True(value)
+}
+`)
+
+	_, err := newGenerator("test", nil).generate([]string{fpath})
+	if err == nil || !strings.Contains(err.Error(), "unsupported import") {
+		t.Fatalf("expected unsupported import error, got %v", err)
+	}
+}
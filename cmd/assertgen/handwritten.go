@@ -0,0 +1,100 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// handwrittenDecls returns the keys (see declKey) of top-level declarations
+// of hand-written files of dir. Files listed in outputs are generated and
+// ignored even if they don't have a generated header yet.
+func handwrittenDecls(dir string, outputs map[string]bool) (map[string]bool, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	decls := make(map[string]bool)
+	for _, fpath := range matches {
+		name := filepath.Base(fpath)
+		if outputs[name] || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		src, err := os.ReadFile(fpath)
+		if err != nil {
+			return nil, err
+		}
+		if isGenerated(src) {
+			continue
+		}
+
+		f, err := parser.ParseFile(token.NewFileSet(), fpath, src, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, decl := range f.Decls {
+			for _, key := range declKeys(decl) {
+				decls[key] = true
+			}
+		}
+	}
+
+	return decls, nil
+}
+
+// declKeys returns the keys identifying the identifiers declared by decl.
+// Methods are prefixed by their receiver base type name (e.g. "CollectT.Errorf").
+func declKeys(decl ast.Decl) []string {
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		return []string{funcKey(decl)}
+
+	case *ast.GenDecl:
+		var keys []string
+		for _, spec := range decl.Specs {
+			switch spec := spec.(type) {
+			case *ast.TypeSpec:
+				keys = append(keys, spec.Name.Name)
+			case *ast.ValueSpec:
+				for _, name := range spec.Names {
+					keys = append(keys, name.Name)
+				}
+			}
+		}
+		return keys
+	}
+
+	return nil
+}
+
+func funcKey(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+
+	return recvTypeName(fn.Recv.List[0].Type) + "." + fn.Name.Name
+}
+
+// recvTypeName returns the base type name of a receiver type expression.
+func recvTypeName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return recvTypeName(expr.X)
+	case *ast.ParenExpr:
+		return recvTypeName(expr.X)
+	case *ast.IndexExpr:
+		return recvTypeName(expr.X)
+	case *ast.IndexListExpr:
+		return recvTypeName(expr.X)
+	case *ast.Ident:
+		return expr.Name
+	}
+
+	return ""
+}
@@ -0,0 +1,189 @@
+// Command assertgen generates the assert package from testify's assert
+// package.
+//
+// For each testify source file, assertgen removes the TestingT parameter of
+// functions and emits two files: one built with the assert tag containing the
+// translated code and a prod_* one (!assert build) where every exported
+// function is an empty stub. It also generates the *f variants of every
+// assertion in assertion_format.go.
+//
+// Declarations of hand-written files of the output directory take precedence
+// over upstream ones. That's how this package customizes testify behavior (see
+// failure.go and overrides.go).
+//
+// assertgen fails if upstream code contains a construct it doesn't know how to
+// translate.
+//
+// Usage:
+//
+//	go run ./cmd/assertgen -version v1.10.0
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const testifyModule = "github.com/stretchr/testify"
+
+// skiplist contains testify files that aren't translated.
+var skiplist = map[string]bool{
+	"doc.go":                true,
+	"assertion_format.go":   true, // Generated from other files.
+	"assertion_forward.go":  true,
+	"forward_assertions.go": true,
+}
+
+// generatedRegexp matches generated files header.
+// See https://go.dev/s/generatedcode.
+var generatedRegexp = regexp.MustCompile(`(?m)^// Code generated .* DO NOT EDIT\.$`)
+
+func main() {
+	version := flag.String("version", "", "testify `version` to download and translate")
+	testifyDir := flag.String("testify", "", "testify assert package `directory` (overrides -version)")
+	outDir := flag.String("out", ".", "output `directory`")
+	flag.Parse()
+
+	if err := run(*version, *testifyDir, *outDir); err != nil {
+		fmt.Fprintln(os.Stderr, "assertgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(version, testifyDir, outDir string) error {
+	if testifyDir == "" {
+		if version == "" {
+			return fmt.Errorf("either -version or -testify flag must be set")
+		}
+
+		dir, err := downloadTestify(version)
+		if err != nil {
+			return err
+		}
+		testifyDir = filepath.Join(dir, "assert")
+	}
+	if version == "" {
+		version = "local"
+	}
+
+	upstream, err := upstreamFiles(testifyDir)
+	if err != nil {
+		return err
+	}
+
+	outputs := map[string]bool{"assertion_format.go": true, "prod_assertion_format.go": true}
+	for _, fpath := range upstream {
+		name := filepath.Base(fpath)
+		outputs[name] = true
+		outputs["prod_"+name] = true
+	}
+
+	overrides, err := handwrittenDecls(outDir, outputs)
+	if err != nil {
+		return err
+	}
+
+	g := newGenerator(testifyModule+"/assert@"+version, overrides)
+	files, err := g.generate(upstream)
+	if err != nil {
+		return err
+	}
+
+	if err := removeGenerated(outDir); err != nil {
+		return err
+	}
+
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(outDir, name), src, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// downloadTestify downloads testify module at the given version and returns
+// its directory.
+func downloadTestify(version string) (string, error) {
+	cmd := exec.Command("go", "mod", "download", "-json", testifyModule+"@"+version)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to download testify: %w", err)
+	}
+
+	var mod struct {
+		Dir   string
+		Error string
+	}
+	if err := json.Unmarshal(out, &mod); err != nil {
+		return "", err
+	}
+	if mod.Error != "" {
+		return "", fmt.Errorf("failed to download testify: %v", mod.Error)
+	}
+
+	return mod.Dir, nil
+}
+
+// upstreamFiles returns the sorted list of testify files to translate.
+func upstreamFiles(dir string) ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, fpath := range matches {
+		name := filepath.Base(fpath)
+		if skiplist[name] || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		files = append(files, fpath)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no go files found in %q", dir)
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+// removeGenerated removes generated go files of dir.
+func removeGenerated(dir string) error {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+
+	for _, fpath := range matches {
+		src, err := os.ReadFile(fpath)
+		if err != nil {
+			return err
+		}
+		if isGenerated(src) {
+			if err := os.Remove(fpath); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// isGenerated reports whether src contains a generated file header before
+// the package clause.
+func isGenerated(src []byte) bool {
+	if i := bytes.Index(src, []byte("\npackage ")); i >= 0 {
+		src = src[:i]
+	}
+	return generatedRegexp.Match(src)
+}
@@ -0,0 +1,17 @@
+// Command assertvet checks usages of github.com/negrel/assert.
+//
+// It is meant to be used as a go vet tool:
+//
+//	go install github.com/negrel/assert/cmd/assertvet@latest
+//	go vet -vettool=$(which assertvet) ./...
+package main
+
+import (
+	"github.com/negrel/assert/analysis/enumswitch"
+	"github.com/negrel/assert/analysis/sideeffects"
+	"golang.org/x/tools/go/analysis/unitchecker"
+)
+
+func main() {
+	unitchecker.Main(enumswitch.Analyzer, sideeffects.Analyzer)
+}
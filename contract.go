@@ -0,0 +1,148 @@
+//go:build assert
+
+package assert
+
+import "reflect"
+
+// Requires asserts that the precondition cond holds. Its failures are labeled
+// "Precondition" in the report.
+//
+//	assert.Requires(n > 0, "n must be positive")
+func Requires(cond bool, msgAndArgs ...interface{}) bool {
+	if !cond {
+		return failWith(&AssertionError{Kind: "Precondition", Message: "Should be true"}, msgAndArgs...)
+	}
+
+	return true
+}
+
+// Requiresf asserts that the precondition cond holds. Its failures are
+// labeled "Precondition" in the report.
+//
+//	assert.Requiresf(n > 0, "error message %s", "formatted")
+func Requiresf(cond bool, msg string, args ...interface{}) bool {
+	return Requires(cond, append([]interface{}{msg}, args...)...)
+}
+
+// Ensures asserts that the postcondition cond holds. It is meant to be
+// deferred at the beginning of a function so cond is evaluated when the
+// function returns. Its failures are labeled "Postcondition" in the report.
+//
+// Use [Old] to compare the state on return with the state on entry:
+//
+//	oldLen := assert.Old(len(q.items))
+//	defer assert.Ensures(func() bool { return len(q.items) == oldLen+1 })
+func Ensures(cond func() bool, msgAndArgs ...interface{}) bool {
+	if !cond() {
+		return failWith(&AssertionError{Kind: "Postcondition", Message: "Should be true"}, msgAndArgs...)
+	}
+
+	return true
+}
+
+// Ensuresf asserts that the postcondition cond holds. See [Ensures].
+//
+//	defer assert.Ensuresf(func() bool { return len(q.items) == oldLen+1 }, "error message %s", "formatted")
+func Ensuresf(cond func() bool, msg string, args ...interface{}) bool {
+	return Ensures(cond, append([]interface{}{msg}, args...)...)
+}
+
+// Old returns a deep copy of v so postconditions (see [Ensures]) can compare
+// the state on return with the state on entry, even if v shares memory (e.g.
+// a slice or a map) modified by the function.
+//
+// When assertions are disabled, Old returns the zero value of T. Its result
+// must only be used in postconditions.
+//
+//	oldItems := assert.Old(q.items)
+func Old[T any](v T) T {
+	// Copy is stored through reflection as type assertion of a nil interface
+	// (e.g. Old[error](nil)) panics.
+	var old T
+	reflect.ValueOf(&old).Elem().Set(deepCopy(reflect.ValueOf(&v).Elem(), make(map[visitKey]reflect.Value)))
+	return old
+}
+
+// visitKey identifies an already visited pointer, slice or map. The type is
+// part of the key as different values may share the same address (e.g. a
+// struct and its first field, or empty slices).
+type visitKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// deepCopy returns a deep copy of v. visited maps already copied pointers,
+// slices and maps to their copy so cyclic values are supported. Map keys,
+// unexported struct fields, channels, functions and unsafe pointers are
+// shallow copied.
+func deepCopy(v reflect.Value, visited map[visitKey]reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		if c, ok := visited[visitKey{v.Pointer(), v.Type()}]; ok {
+			return c
+		}
+		c := reflect.New(v.Type().Elem())
+		visited[visitKey{v.Pointer(), v.Type()}] = c
+		c.Elem().Set(deepCopy(v.Elem(), visited))
+		return c
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopy(v.Elem(), visited))
+		return c
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		if c, ok := visited[visitKey{v.Pointer(), v.Type()}]; ok && c.Len() == v.Len() {
+			return c
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		visited[visitKey{v.Pointer(), v.Type()}] = c
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i), visited))
+		}
+		return c
+
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		if c, ok := visited[visitKey{v.Pointer(), v.Type()}]; ok {
+			return c
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		visited[visitKey{v.Pointer(), v.Type()}] = c
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), deepCopy(iter.Value(), visited))
+		}
+		return c
+
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i), visited))
+		}
+		return c
+
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(deepCopy(v.Field(i), visited))
+			}
+		}
+		return c
+	}
+
+	return v
+}
@@ -23,6 +23,11 @@
 // both case, program panics if it was compiled with the `assert` tags
 // (`go build -tags assert ./path/to/my/package`)
 //
+// Without the `assert` tag, every assertion is an empty function that returns
+// true. Helpers that do no assertion, such as ObjectsAreEqual or CallerInfo,
+// behave the same in both builds.
+//
 // Every assertion function also takes an optional string message as the final argument,
 // allowing custom error messages to be appended to the message the assertion method outputs.
+// A func() string message or format argument is only called if the assertion fails.
 package assert
@@ -20,6 +20,7 @@ type TryLocker interface {
 //	assert.IsDecreasing([]string{"b", "a"})
 func Locked(locker TryLocker, msgAndArgs ...any) {
 	if locker.TryLock() {
+		locker.Unlock()
 		Fail("Expected sync.Locker to be locked", msgAndArgs...)
 	}
 }
@@ -41,6 +42,7 @@ func Lockedf(locker TryLocker, msg string, args ...any) {
 func Unlocked(locker TryLocker, msgAndArgs ...any) {
 	if !locker.TryLock() {
 		Fail("Expected sync.Locker to be unlocked", msgAndArgs...)
+		return
 	}
 	locker.Unlock()
 }
@@ -53,3 +55,112 @@ func Unlocked(locker TryLocker, msgAndArgs ...any) {
 func Unlockedf(locker TryLocker, msg string, args ...any) {
 	Unlocked(locker, append([]interface{}{msg}, args...)...)
 }
+
+// A TryRLocker represents a reader/writer lock that can attempt to acquire a
+// read lock and report whether it succeeded.
+//
+// [sync.RWMutex] and [RWMutex] implements this interface.
+type TryRLocker interface {
+	TryLocker
+	RLock()
+	RUnlock()
+	TryRLock() bool
+}
+
+// isWriteLocked reports whether rw is known to be locked for writing. It must
+// be called while rw is locked and TryRLock failed. Such state is ambiguous for
+// a [sync.RWMutex] as a pending writer also prevents read locking, so false is
+// returned unless rw records its owner (see [RWMutex]).
+func isWriteLocked(rw TryRLocker) bool {
+	if owned, ok := rw.(OwnedLocker); ok {
+		return owned.lockState().owner.Load() != 0
+	}
+
+	return false
+}
+
+// RLocked asserts that the [TryRLocker] is locked for reading.
+//
+// A [sync.RWMutex] locked for writing can't be distinguished from one locked
+// for reading with a pending writer, use [RWMutex] to detect it.
+//
+//	assert.RLocked(&rw)
+func RLocked(rw TryRLocker, msgAndArgs ...any) bool {
+	if rw.TryLock() {
+		rw.Unlock()
+		return Fail("Expected sync.RWMutex to be read locked", msgAndArgs...)
+	}
+
+	if rw.TryRLock() {
+		rw.RUnlock()
+	} else if isWriteLocked(rw) {
+		return Fail("Expected sync.RWMutex to be read locked but it is write locked", msgAndArgs...)
+	}
+
+	return true
+}
+
+// RLockedf asserts that the [TryRLocker] is locked for reading.
+//
+// A [sync.RWMutex] locked for writing can't be distinguished from one locked
+// for reading with a pending writer, use [RWMutex] to detect it.
+//
+//	assert.RLockedf(&rw, "error message %s", "formatted")
+func RLockedf(rw TryRLocker, msg string, args ...any) bool {
+	return RLocked(rw, append([]interface{}{msg}, args...)...)
+}
+
+// RUnlocked asserts that the [TryRLocker] isn't locked for reading. It may be
+// locked for writing.
+//
+// A [sync.RWMutex] locked for reading with a pending writer can't be
+// distinguished from one locked for writing, use [RWMutex] to detect it.
+//
+//	assert.RUnlocked(&rw)
+func RUnlocked(rw TryRLocker, msgAndArgs ...any) bool {
+	if rw.TryLock() {
+		rw.Unlock()
+		return true
+	}
+
+	if rw.TryRLock() {
+		rw.RUnlock()
+		return Fail("Expected sync.RWMutex not to be read locked", msgAndArgs...)
+	} else if _, ok := rw.(OwnedLocker); ok && !isWriteLocked(rw) {
+		return Fail("Expected sync.RWMutex not to be read locked", msgAndArgs...)
+	}
+
+	return true
+}
+
+// RUnlockedf asserts that the [TryRLocker] isn't locked for reading. It may be
+// locked for writing.
+//
+// A [sync.RWMutex] locked for reading with a pending writer can't be
+// distinguished from one locked for writing, use [RWMutex] to detect it.
+//
+//	assert.RUnlockedf(&rw, "error message %s", "formatted")
+func RUnlockedf(rw TryRLocker, msg string, args ...any) bool {
+	return RUnlocked(rw, append([]interface{}{msg}, args...)...)
+}
+
+// LockedAny asserts that the [TryRLocker] is locked for either reading or
+// writing.
+//
+//	assert.LockedAny(&rw)
+func LockedAny(rw TryRLocker, msgAndArgs ...any) bool {
+	if rw.TryLock() {
+		rw.Unlock()
+		return Fail("Expected sync.RWMutex to be locked", msgAndArgs...)
+	}
+
+	return true
+}
+
+// LockedAnyf asserts that the [TryRLocker] is locked for either reading or
+// writing.
+//
+//	assert.LockedAnyf(&rw, "error message %s", "formatted")
+func LockedAnyf(rw TryRLocker, msg string, args ...any) bool {
+	return LockedAny(rw, append([]interface{}{msg}, args...)...)
+}
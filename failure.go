@@ -0,0 +1,214 @@
+//go:build assert
+
+package assert
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"runtime"
+	"strings"
+	"sync/atomic"
+)
+
+// AssertionError describes a failed assertion. It is the error passed to the
+// [FailureHandler], and thus the panic value of failed assertions when the
+// default [PanicHandler] is used.
+type AssertionError struct {
+	// Assertion is the name of the failed assertion function (e.g. "Equal").
+	Assertion string
+	// Kind is the failure category (e.g. "Precondition") used to label
+	// Message in the report. It is empty for regular assertions whose message
+	// is labeled "Error".
+	Kind string
+	// Message describes the failure.
+	Message string
+	// Expression is the source code of the failed assertion call (e.g.
+	// "assert.True(len(q.items) <= q.cap)"). It is empty if the source file
+	// isn't available at runtime.
+	Expression string
+	// UserMessage is the optional message built from msgAndArgs.
+	UserMessage string
+	// Trace contains the file and line number of each caller frame leading to
+	// the failed assertion, as returned by [CallerInfo]. Entries are rendered
+	// according to [TraceOptions] and may span multiple lines.
+	Trace []string
+	// Expected and Actual are the compared values of assertions such as
+	// [Equal]. They're nil when not applicable.
+	Expected, Actual interface{}
+	// Diff is the unified diff of Expected and Actual, if any.
+	Diff string
+	// Err is the error checked by assertions such as [NoError] or [ErrorIs].
+	Err error
+	// Fields contains additional labeled values of the report: fields of the
+	// [Report], fields passed with [With] and goroutine annotations (see
+	// [Annotate]).
+	Fields []Field
+}
+
+// Error implements the error interface. It returns the labeled report of the
+// failure.
+func (e *AssertionError) Error() string {
+	kind := e.Kind
+	if kind == "" {
+		kind = "Error"
+	}
+
+	content := []labeledContent{
+		{"Error Trace", strings.ReplaceAll(strings.Join(e.Trace, "\n"), "\n", "\n\t\t\t")},
+	}
+	if e.Expression != "" {
+		content = append(content, labeledContent{"Expression", e.Expression})
+	}
+	content = append(content, labeledContent{kind, e.Message})
+	for _, f := range e.Fields {
+		content = append(content, labeledContent{f.Key, fmt.Sprint(f.Value)})
+	}
+
+	if len(e.UserMessage) > 0 {
+		content = append(content, labeledContent{"Messages", e.UserMessage})
+	}
+
+	return "\n" + labeledOutput(content...)
+}
+
+// Unwrap returns the error checked by the failed assertion, if any.
+func (e *AssertionError) Unwrap() error {
+	return e.Err
+}
+
+// failWith completes e with the assertion name, source expression, caller
+// frames and user message and reports it through the current failure handler.
+func failWith(e *AssertionError, msgAndArgs ...interface{}) bool {
+	name, callee, caller := assertionCall()
+	e.Assertion = name
+	e.Expression = sourceExpr(caller, callee)
+
+	return reportFailure(e, msgAndArgs...)
+}
+
+// reportFailure completes e with the caller frames, user message and fields
+// (see [With] and [Annotate]) and reports it through the current failure
+// handler.
+func reportFailure(e *AssertionError, msgAndArgs ...interface{}) bool {
+	msgAndArgs, fields := splitFields(msgAndArgs)
+	e.Fields = append(append(e.Fields[:len(e.Fields):len(e.Fields)], fields...), goroutineAnnotations()...)
+	e.Trace = callerTrace()
+	e.UserMessage = messageFromMsgAndArgs(evalLazyArgs(msgAndArgs)...)
+
+	handleFailure(e)
+
+	return false
+}
+
+// assertionCall returns the name of the outermost function of this package
+// in the current call stack, that is the assertion called by the user, the
+// name of the function called by the first frame not marked with [Helper]
+// (the assertion or the outermost helper) and that frame.
+func assertionCall() (name, callee string, caller runtime.Frame) {
+	pc := make([]uintptr, 32)
+	n := runtime.Callers(2, pc)
+	frames := runtime.CallersFrames(pc[:n])
+
+	for {
+		frame, more := frames.Next()
+		if fn, ok := strings.CutPrefix(frame.Function, pkgPath+"."); ok {
+			if callee == "" {
+				name = fn
+			}
+		} else if name != "" {
+			if _, helper := helperFuncs.Load(frame.Function); !helper {
+				caller = frame
+				break
+			}
+			callee = shortFuncName(frame.Function)
+			// Strip package name.
+			callee = callee[strings.IndexByte(callee, '.')+1:]
+		}
+		if !more {
+			break
+		}
+	}
+
+	name = trimFuncSuffix(name)
+	if callee == "" {
+		return name, name, caller
+	}
+	return name, trimFuncSuffix(callee), caller
+}
+
+// trimFuncSuffix strips closure suffix and type parameters of a function
+// name.
+func trimFuncSuffix(name string) string {
+	if i := strings.Index(name, ".func"); i >= 0 {
+		name = name[:i]
+	}
+	if i := strings.IndexByte(name, '['); i >= 0 {
+		name = name[:i]
+	}
+
+	return name
+}
+
+// A FailureHandler is called by Fail each time an assertion fails. err
+// describes the failure.
+//
+// If the handler returns, the assertion returns false and the program
+// continues its execution.
+type FailureHandler func(err error)
+
+var failureHandler atomic.Pointer[FailureHandler]
+
+// SetFailureHandler sets the handler called on assertion failures and returns
+// the previous one. A nil handler restores the default [PanicHandler].
+//
+// It is safe to call SetFailureHandler concurrently with failing assertions.
+func SetFailureHandler(h FailureHandler) FailureHandler {
+	if h == nil {
+		h = PanicHandler
+	}
+
+	prev := failureHandler.Swap(&h)
+	if prev == nil {
+		return PanicHandler
+	}
+	return *prev
+}
+
+// handleFailure forwards err to the current failure handler.
+func handleFailure(err error) {
+	h := failureHandler.Load()
+	if h == nil {
+		PanicHandler(err)
+		return
+	}
+	(*h)(err)
+}
+
+// PanicHandler is the default [FailureHandler], it panics with err.
+func PanicHandler(err error) {
+	panic(err)
+}
+
+// LogHandler is a [FailureHandler] that logs err using the standard logger
+// and lets the program continue.
+func LogHandler(err error) {
+	log.Printf("assertion failed:%s", err)
+}
+
+// ExitHandler returns a [FailureHandler] that prints err to stderr and exits
+// the program with the given status code.
+func ExitHandler(code int) FailureHandler {
+	return func(err error) {
+		fmt.Fprintf(os.Stderr, "assertion failed:%s", err)
+		os.Exit(code)
+	}
+}
+
+// BreakpointHandler is a [FailureHandler] that prints err to stderr and
+// executes a breakpoint trap so an attached debugger stops right where the
+// assertion failed.
+func BreakpointHandler(err error) {
+	fmt.Fprintf(os.Stderr, "assertion failed:%s", err)
+	runtime.Breakpoint()
+}
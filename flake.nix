@@ -11,7 +11,7 @@
         in {
           devShells = {
             default = pkgs.mkShell {
-              buildInputs = with pkgs; [ go gopls gotools shellcheck ];
+              buildInputs = with pkgs; [ go gopls gotools ];
             };
           };
         });
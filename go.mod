@@ -1,7 +1,14 @@
 module github.com/negrel/assert
 
-go 1.20
+go 1.22.0
 
 require github.com/davecgh/go-spew v1.1.1
 
 require github.com/pmezard/go-difflib v1.0.0
+
+require golang.org/x/tools v0.30.0
+
+require (
+	golang.org/x/mod v0.23.0 // indirect
+	golang.org/x/sync v0.11.0 // indirect
+)
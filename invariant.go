@@ -0,0 +1,145 @@
+//go:build assert
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Invariant is implemented by types that check their own invariants. See
+// [Valid].
+type Invariant interface {
+	// CheckInvariant returns an error describing the first broken invariant,
+	// if any.
+	CheckInvariant() error
+}
+
+var invariantType = reflect.TypeOf((*Invariant)(nil)).Elem()
+
+// Valid asserts that v and every value reachable from its exported struct
+// fields, slice and array elements, map keys and values implementing
+// [Invariant] satisfy their invariants. The path of the first violation (e.g.
+// "root.Items[3].Owner") is reported as well as the error returned by
+// CheckInvariant.
+//
+//	assert.Valid(order)
+func Valid(v interface{}, msgAndArgs ...interface{}) bool {
+	path, err := checkInvariants(addressable(reflect.ValueOf(v)), "root", make(map[visitKey]bool))
+	if err != nil {
+		return failWith(&AssertionError{
+			Kind:    "Invariant",
+			Message: fmt.Sprintf("%s: %v", path, err),
+			Err:     err,
+		}, msgAndArgs...)
+	}
+
+	return true
+}
+
+// Validf asserts that v and every value reachable from it satisfy their
+// invariants. See [Valid].
+//
+//	assert.Validf(order, "error message %s", "formatted")
+func Validf(v interface{}, msg string, args ...interface{}) bool {
+	return Valid(v, append([]interface{}{msg}, args...)...)
+}
+
+// checkInvariants checks invariants of v and values reachable from it. It
+// returns the path of the first violation and the error returned by
+// CheckInvariant. visited contains already checked pointers.
+func checkInvariants(v reflect.Value, path string, visited map[visitKey]bool) (string, error) {
+	if !v.IsValid() {
+		return "", nil
+	}
+
+	if err := checkInvariant(v); err != nil {
+		return path, err
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		key := visitKey{v.Pointer(), v.Type()}
+		if v.IsNil() || visited[key] {
+			return "", nil
+		}
+		visited[key] = true
+		return checkInvariants(v.Elem(), path, visited)
+
+	case reflect.Interface:
+		return checkInvariants(addressable(v.Elem()), path, visited)
+
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if path, err := checkInvariants(v.Field(i), path+"."+field.Name, visited); err != nil {
+				return path, err
+			}
+		}
+
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if path, err := checkInvariants(v.Index(i), fmt.Sprintf("%s[%d]", path, i), visited); err != nil {
+				return path, err
+			}
+		}
+
+	case reflect.Map:
+		keys := v.MapKeys()
+		// Sort keys so the reported violation is deterministic.
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+		})
+		for _, key := range keys {
+			keyPath := fmt.Sprintf("%s[%#v]", path, key)
+			if path, err := checkInvariants(addressable(key), keyPath, visited); err != nil {
+				return path, err
+			}
+			if path, err := checkInvariants(addressable(v.MapIndex(key)), keyPath, visited); err != nil {
+				return path, err
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// addressable returns v if it is addressable or an addressable copy of v
+// otherwise, so that pointer receiver CheckInvariant methods of v and of its
+// fields can be called.
+func addressable(v reflect.Value) reflect.Value {
+	if !v.IsValid() || v.CanAddr() {
+		return v
+	}
+
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	return c
+}
+
+// checkInvariant calls CheckInvariant method of v if it implements
+// [Invariant]. Pointer receiver methods are called if v is addressable,
+// which is the case of values passed to checkInvariants.
+// Pointers are ignored as their element is checked instead.
+func checkInvariant(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Pointer:
+		return nil
+	case reflect.Interface:
+		// Dynamic value is checked by caller.
+		return nil
+	}
+
+	if v.Type().Implements(invariantType) {
+		return v.Interface().(Invariant).CheckInvariant()
+	}
+	if v.CanAddr() && reflect.PointerTo(v.Type()).Implements(invariantType) {
+		return v.Addr().Interface().(Invariant).CheckInvariant()
+	}
+
+	return nil
+}
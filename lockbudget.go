@@ -0,0 +1,174 @@
+//go:build assert
+
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// A LockBudget defines time limits of a [Mutex] or [RWMutex]. A zero duration
+// disables the corresponding limit.
+type LockBudget struct {
+	// Hold is the maximum duration the (write) lock may be held.
+	Hold time.Duration
+	// Wait is the maximum duration a goroutine may block acquiring the lock.
+	Wait time.Duration
+}
+
+var defaultLockBudget atomic.Pointer[LockBudget]
+
+// SetDefaultLockBudget sets the budget of [Mutex] and [RWMutex] without one
+// (see [Mutex.SetBudget]) and returns the previous default budget. The zero
+// LockBudget, which is the default, disables budgets.
+func SetDefaultLockBudget(b LockBudget) LockBudget {
+	prev := defaultLockBudget.Swap(&b)
+	if prev == nil {
+		return LockBudget{}
+	}
+	return *prev
+}
+
+// SetBudget sets the budget of m. Locks held or waited for longer than the
+// budget are reported through [Fail] along with the stack of the acquisition.
+// SetBudget must be called before m is used.
+func (m *Mutex) SetBudget(b LockBudget) {
+	m.state.budget = &b
+}
+
+// SetBudget sets the budget of rw. Hold limit applies to the write lock and
+// to each read lock. See [Mutex.SetBudget].
+func (rw *RWMutex) SetBudget(b LockBudget) {
+	rw.state.budget = &b
+}
+
+// lockBudget returns the budget of the lock.
+func (s *mutexState) lockBudget() LockBudget {
+	if s.budget != nil {
+		return *s.budget
+	}
+	if b := defaultLockBudget.Load(); b != nil {
+		return *b
+	}
+	return LockBudget{}
+}
+
+// startWait returns the time at which the calling goroutine starts to wait
+// for the lock or the zero time if wait limit is disabled.
+func (s *mutexState) startWait() time.Time {
+	if s.lockBudget().Wait <= 0 {
+		return time.Time{}
+	}
+	return time.Now()
+}
+
+// waitViolation returns the failure message of a goroutine that waited
+// longer than the budget since start to acquire a lock of the given kind or
+// an empty string. It is reported once the lock is released so that other
+// goroutines aren't blocked if failure handler panics.
+func (s *mutexState) waitViolation(kind string, start time.Time) string {
+	if start.IsZero() {
+		return ""
+	}
+
+	budget := s.lockBudget().Wait
+	waited := time.Since(start)
+	if waited <= budget {
+		return ""
+	}
+	return fmt.Sprintf("Goroutine blocked for %v acquiring %v, longer than its %v wait budget", waited, kind, budget)
+}
+
+// lockHold contains the acquisition time and stack of a lock, and the wait
+// budget violation of its acquisition, if any.
+type lockHold struct {
+	at    time.Time
+	stack []uintptr
+	wait  string
+}
+
+// startHold records the acquisition time and stack of the lock if hold limit
+// is enabled or if wait is a wait budget violation. It must be called by the
+// goroutine holding the write lock.
+func (s *mutexState) startHold(wait string) {
+	if s.lockBudget().Hold <= 0 && wait == "" {
+		s.acquired = lockHold{}
+		return
+	}
+
+	s.acquired = lockHold{time.Now(), callers(5), wait}
+}
+
+// holdViolation returns the failure message of a lock held or waited for
+// longer than its budget or an empty string.
+func (s *mutexState) holdViolation(kind string) string {
+	return s.acquired.violation(kind, s.lockBudget().Hold)
+}
+
+// startReadHold records the acquisition time and stack of a read lock held
+// by goroutine gid if hold limit is enabled or if wait is a wait budget
+// violation.
+func (s *mutexState) startReadHold(gid int64, wait string) {
+	if s.lockBudget().Hold <= 0 && wait == "" {
+		return
+	}
+
+	hold := lockHold{time.Now(), callers(4), wait}
+	s.readMu.Lock()
+	defer s.readMu.Unlock()
+	if s.readHolds == nil {
+		s.readHolds = make(map[int64][]lockHold)
+	}
+	s.readHolds[gid] = append(s.readHolds[gid], hold)
+	s.readers.Add(1)
+}
+
+// readHoldViolation releases the last read lock recorded for goroutine gid
+// and returns the failure message if it was held longer than its budget or
+// an empty string. Read locks released by another goroutine than the one
+// that acquired them aren't checked.
+func (s *mutexState) readHoldViolation(gid int64) string {
+	s.readMu.Lock()
+	holds := s.readHolds[gid]
+	if len(holds) == 0 {
+		s.readMu.Unlock()
+		return ""
+	}
+	hold := holds[len(holds)-1]
+	if len(holds) == 1 {
+		delete(s.readHolds, gid)
+	} else {
+		s.readHolds[gid] = holds[:len(holds)-1]
+	}
+	s.readers.Add(-1)
+	s.readMu.Unlock()
+
+	return hold.violation("RWMutex read lock", s.lockBudget().Hold)
+}
+
+// violation returns the failure message of a lock of the given kind held
+// longer than budget or acquired after waiting longer than its wait budget,
+// or an empty string.
+func (h lockHold) violation(kind string, budget time.Duration) string {
+	if h.at.IsZero() {
+		return ""
+	}
+
+	var b strings.Builder
+	if h.wait != "" {
+		b.WriteString(h.wait)
+		b.WriteString("\n")
+	}
+	if held := time.Since(h.at); budget > 0 && held > budget {
+		fmt.Fprintf(&b, "%v held for %v, longer than its %v hold budget\n", kind, held, budget)
+	}
+	if b.Len() == 0 {
+		return ""
+	}
+
+	b.WriteString("\nacquired at:\n")
+	writeStack(&b, h.stack)
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,197 @@
+//go:build assert
+
+package assert
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+var lockOrderChecks atomic.Bool
+
+// SetLockOrderChecks enables or disables lock order checks and returns the
+// previous setting. Lock order checks are disabled by default.
+//
+// When enabled, [Mutex] and [RWMutex] record the order in which each goroutine
+// acquires them in a global lock graph. The first time an acquisition creates
+// a cycle in the graph (e.g. a goroutine locks A then B while another locks B
+// then A), a potential deadlock is reported through [Fail] with the stacks of
+// both acquisitions, even if the program didn't actually deadlock.
+//
+// Locks are identified by their address and stay in the graph forever, so
+// mutexes tracked while checks are enabled are never garbage collected.
+func SetLockOrderChecks(enabled bool) bool {
+	return lockOrderChecks.Swap(enabled)
+}
+
+// lockOrderEdge records that a goroutine acquired a lock while holding
+// another one.
+type lockOrderEdge struct {
+	// stack contains the program counters of the acquisition.
+	stack []uintptr
+}
+
+// lockOrderGraph is a directed graph of locks. An edge from A to B means that
+// B was acquired while holding A.
+type lockOrderGraph struct {
+	mu    sync.Mutex
+	edges map[*mutexState]map[*mutexState]*lockOrderEdge
+	// held contains locks held by each goroutine in acquisition order.
+	held map[int64][]*mutexState
+	// tracked is the number of locks in held.
+	tracked atomic.Int64
+}
+
+var lockOrder = lockOrderGraph{
+	edges: make(map[*mutexState]map[*mutexState]*lockOrderEdge),
+	held:  make(map[int64][]*mutexState),
+}
+
+// check reports lock order violations caused by the acquisition of lock by
+// goroutine gid.
+func (g *lockOrderGraph) check(lock *mutexState, gid int64) {
+	if !lockOrderChecks.Load() {
+		return
+	}
+
+	g.mu.Lock()
+	var newEdges []*mutexState
+	var cycleFrom *mutexState
+	var cycle []*lockOrderEdge
+	for _, held := range g.held[gid] {
+		if held == lock || g.edges[held][lock] != nil {
+			continue
+		}
+		if cycle == nil {
+			if path := g.path(lock, held); path != nil {
+				cycleFrom, cycle = held, path
+				continue
+			}
+		}
+		newEdges = append(newEdges, held)
+	}
+
+	var edge *lockOrderEdge
+	if len(newEdges) > 0 || cycle != nil {
+		edge = &lockOrderEdge{stack: callers(4)}
+		for _, held := range newEdges {
+			g.addEdge(held, lock, edge)
+		}
+		if cycle != nil {
+			// Record the edge so the cycle is reported only once.
+			g.addEdge(cycleFrom, lock, edge)
+		}
+	}
+	g.mu.Unlock()
+
+	if cycle != nil {
+		Fail(lockOrderReport(lock, cycleFrom, edge, cycle))
+	}
+}
+
+// path returns the edges of a path from src to dst or nil if there is none.
+func (g *lockOrderGraph) path(src, dst *mutexState) []*lockOrderEdge {
+	visited := make(map[*mutexState]bool)
+
+	var visit func(node *mutexState) []*lockOrderEdge
+	visit = func(node *mutexState) []*lockOrderEdge {
+		visited[node] = true
+		for next, edge := range g.edges[node] {
+			if next == dst {
+				return []*lockOrderEdge{edge}
+			}
+			if !visited[next] {
+				if path := visit(next); path != nil {
+					return append([]*lockOrderEdge{edge}, path...)
+				}
+			}
+		}
+		return nil
+	}
+
+	return visit(src)
+}
+
+func (g *lockOrderGraph) addEdge(from, to *mutexState, edge *lockOrderEdge) {
+	if g.edges[from] == nil {
+		g.edges[from] = make(map[*mutexState]*lockOrderEdge)
+	}
+	g.edges[from][to] = edge
+}
+
+// acquired records that goroutine gid holds lock.
+func (g *lockOrderGraph) acquired(lock *mutexState, gid int64) {
+	if !lockOrderChecks.Load() {
+		return
+	}
+
+	g.mu.Lock()
+	g.held[gid] = append(g.held[gid], lock)
+	g.tracked.Add(1)
+	g.mu.Unlock()
+}
+
+// released records that goroutine gid released lock.
+func (g *lockOrderGraph) released(lock *mutexState, gid int64) {
+	// Locks acquired before checks were enabled aren't tracked.
+	if g.tracked.Load() == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	held := g.held[gid]
+	for i := len(held) - 1; i >= 0; i-- {
+		if held[i] == lock {
+			held = append(held[:i], held[i+1:]...)
+			g.tracked.Add(-1)
+			break
+		}
+	}
+
+	if len(held) == 0 {
+		delete(g.held, gid)
+	} else {
+		g.held[gid] = held
+	}
+}
+
+// lockOrderReport returns the failure message of an acquisition of lock
+// while holding lock held that creates the given cycle.
+func lockOrderReport(lock, held *mutexState, edge *lockOrderEdge, cycle []*lockOrderEdge) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Lock order inversion: acquiring lock %p while holding lock %p\n", lock, held)
+	b.WriteString("\nacquisition stack:\n")
+	writeStack(&b, edge.stack)
+	fmt.Fprintf(&b, "\nlock %p was previously acquired (directly or indirectly) before lock %p:\n", lock, held)
+	for i, edge := range cycle {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		writeStack(&b, edge.stack)
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// callers returns the program counters of the calling goroutine stack,
+// skipping the given number of frames.
+func callers(skip int) []uintptr {
+	pc := make([]uintptr, 32)
+	return pc[:runtime.Callers(skip, pc)]
+}
+
+func writeStack(b *strings.Builder, pc []uintptr) {
+	frames := runtime.CallersFrames(pc)
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(b, "\t%s\n\t\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			break
+		}
+	}
+}
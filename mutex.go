@@ -0,0 +1,273 @@
+//go:build assert
+
+package assert
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// An OwnedLocker is a [sync.Locker] that records which goroutine holds it.
+//
+// [Mutex] and [RWMutex] implements this interface.
+type OwnedLocker interface {
+	sync.Locker
+	lockState() *mutexState
+}
+
+// mutexState contains debug information of [Mutex] and [RWMutex].
+type mutexState struct {
+	// owner is the ID of the goroutine holding the (write) lock or 0.
+	owner atomic.Int64
+
+	// budget is the lock budget or nil if the default one is used.
+	budget *LockBudget
+	// acquired contains time and stack of the (write) lock acquisition when
+	// hold limit is enabled or wait limit was exceeded.
+	acquired lockHold
+
+	// readHolds contains time and stack of read lock acquisitions by
+	// goroutine ID when hold limit is enabled or wait limit was exceeded.
+	// readers is the number of
+	// entries in readHolds.
+	readMu    sync.Mutex
+	readHolds map[int64][]lockHold
+	readers   atomic.Int64
+}
+
+// lock records that goroutine gid acquired the lock. wait is the wait budget
+// violation of the acquisition, if any.
+func (s *mutexState) lock(gid int64, wait string) {
+	s.owner.Store(gid)
+	s.startHold(wait)
+	lockOrder.acquired(s, gid)
+}
+
+// unlock records that goroutine gid releases the lock and calls unlock if
+// lock is held.
+func (s *mutexState) unlock(kind string, gid int64, unlock func()) {
+	owner := s.owner.Load()
+	if owner == 0 {
+		Fail(fmt.Sprintf("Unlock of unlocked %v", kind))
+		return
+	}
+	if owner != gid {
+		Fail(fmt.Sprintf("%v locked by goroutine %d unlocked by goroutine %d", kind, owner, gid))
+	}
+
+	violation := s.holdViolation(kind)
+	s.owner.Store(0)
+	lockOrder.released(s, owner)
+	unlock()
+
+	// Report hold budget violation once the lock is released so other
+	// goroutines aren't blocked if failure handler panics.
+	if violation != "" {
+		Fail(violation)
+	}
+}
+
+// A Mutex is a [sync.Mutex] that records the goroutine holding it when
+// assertions are enabled. It reports recursive locking, unlocking of an
+// unlocked mutex and unlocking by another goroutine through [Fail]. It is a
+// plain [sync.Mutex] otherwise.
+//
+// A Mutex must not be copied after first use.
+type Mutex struct {
+	state mutexState
+	mu    sync.Mutex
+}
+
+// Lock locks m. See [sync.Mutex.Lock].
+func (m *Mutex) Lock() {
+	gid := goid()
+	if m.state.owner.Load() == gid {
+		Fail("Mutex locked twice by the same goroutine")
+	}
+	lockOrder.check(&m.state, gid)
+
+	start := m.state.startWait()
+	m.mu.Lock()
+	m.state.lock(gid, m.state.waitViolation("Mutex", start))
+}
+
+// TryLock tries to lock m and reports whether it succeeded. See
+// [sync.Mutex.TryLock].
+func (m *Mutex) TryLock() bool {
+	if !m.mu.TryLock() {
+		return false
+	}
+
+	m.state.lock(goid(), "")
+	return true
+}
+
+// Unlock unlocks m. See [sync.Mutex.Unlock].
+func (m *Mutex) Unlock() {
+	m.state.unlock("Mutex", goid(), m.mu.Unlock)
+}
+
+func (m *Mutex) lockState() *mutexState {
+	return &m.state
+}
+
+// A RWMutex is a [sync.RWMutex] that records the goroutine holding the
+// write lock when assertions are enabled. See [Mutex].
+//
+// A RWMutex must not be copied after first use.
+type RWMutex struct {
+	state mutexState
+	mu    sync.RWMutex
+}
+
+// Lock locks rw for writing. See [sync.RWMutex.Lock].
+func (rw *RWMutex) Lock() {
+	gid := goid()
+	if rw.state.owner.Load() == gid {
+		Fail("RWMutex locked twice by the same goroutine")
+	}
+	lockOrder.check(&rw.state, gid)
+
+	start := rw.state.startWait()
+	rw.mu.Lock()
+	rw.state.lock(gid, rw.state.waitViolation("RWMutex", start))
+}
+
+// TryLock tries to lock rw for writing and reports whether it succeeded. See
+// [sync.RWMutex.TryLock].
+func (rw *RWMutex) TryLock() bool {
+	if !rw.mu.TryLock() {
+		return false
+	}
+
+	rw.state.lock(goid(), "")
+	return true
+}
+
+// Unlock unlocks rw for writing. See [sync.RWMutex.Unlock].
+func (rw *RWMutex) Unlock() {
+	rw.state.unlock("RWMutex", goid(), rw.mu.Unlock)
+}
+
+// RLock locks rw for reading. See [sync.RWMutex.RLock].
+func (rw *RWMutex) RLock() {
+	gid := goid()
+	if rw.state.owner.Load() == gid {
+		Fail("RWMutex read locked by the goroutine holding its write lock")
+	}
+	lockOrder.check(&rw.state, gid)
+
+	start := rw.state.startWait()
+	rw.mu.RLock()
+	lockOrder.acquired(&rw.state, gid)
+	rw.state.startReadHold(gid, rw.state.waitViolation("RWMutex", start))
+}
+
+// TryRLock tries to lock rw for reading and reports whether it succeeded. See
+// [sync.RWMutex.TryRLock].
+func (rw *RWMutex) TryRLock() bool {
+	if !rw.mu.TryRLock() {
+		return false
+	}
+
+	if lockOrderChecks.Load() || rw.state.lockBudget().Hold > 0 {
+		gid := goid()
+		lockOrder.acquired(&rw.state, gid)
+		rw.state.startReadHold(gid, "")
+	}
+	return true
+}
+
+// RUnlock undoes a single [RWMutex.RLock] call. See [sync.RWMutex.RUnlock].
+func (rw *RWMutex) RUnlock() {
+	violation := ""
+	if lockOrder.tracked.Load() != 0 || rw.state.readers.Load() != 0 {
+		gid := goid()
+		lockOrder.released(&rw.state, gid)
+		violation = rw.state.readHoldViolation(gid)
+	}
+	rw.mu.RUnlock()
+
+	// See mutexState.unlock.
+	if violation != "" {
+		Fail(violation)
+	}
+}
+
+// RLocker returns a [sync.Locker] interface that implements the Lock and
+// Unlock methods by calling rw.RLock and rw.RUnlock.
+func (rw *RWMutex) RLocker() sync.Locker {
+	return (*rlocker)(rw)
+}
+
+func (rw *RWMutex) lockState() *mutexState {
+	return &rw.state
+}
+
+type rlocker RWMutex
+
+func (r *rlocker) Lock()   { (*RWMutex)(r).RLock() }
+func (r *rlocker) Unlock() { (*RWMutex)(r).RUnlock() }
+
+// LockedByCaller asserts that the [OwnedLocker] is locked by the calling
+// goroutine.
+//
+//	assert.LockedByCaller(&mu)
+func LockedByCaller(locker OwnedLocker, msgAndArgs ...interface{}) bool {
+	if locker.lockState().owner.Load() != goid() {
+		return Fail("Expected lock to be held by the calling goroutine", msgAndArgs...)
+	}
+
+	return true
+}
+
+// LockedByCallerf asserts that the [OwnedLocker] is locked by the calling
+// goroutine.
+//
+//	assert.LockedByCallerf(&mu, "error message %s", "formatted")
+func LockedByCallerf(locker OwnedLocker, msg string, args ...interface{}) bool {
+	return LockedByCaller(locker, append([]interface{}{msg}, args...)...)
+}
+
+// NotLockedByCaller asserts that the [OwnedLocker] isn't locked by the
+// calling goroutine. It may be locked by another goroutine.
+//
+//	assert.NotLockedByCaller(&mu)
+func NotLockedByCaller(locker OwnedLocker, msgAndArgs ...interface{}) bool {
+	if locker.lockState().owner.Load() == goid() {
+		return Fail("Expected lock not to be held by the calling goroutine", msgAndArgs...)
+	}
+
+	return true
+}
+
+// NotLockedByCallerf asserts that the [OwnedLocker] isn't locked by the
+// calling goroutine. It may be locked by another goroutine.
+//
+//	assert.NotLockedByCallerf(&mu, "error message %s", "formatted")
+func NotLockedByCallerf(locker OwnedLocker, msg string, args ...interface{}) bool {
+	return NotLockedByCaller(locker, append([]interface{}{msg}, args...)...)
+}
+
+var goroutinePrefix = []byte("goroutine ")
+
+// goid returns the ID of the calling goroutine.
+func goid() int64 {
+	var buf [64]byte
+	b := buf[:runtime.Stack(buf[:], false)]
+	b = bytes.TrimPrefix(b, goroutinePrefix)
+	if i := bytes.IndexByte(b, ' '); i >= 0 {
+		b = b[:i]
+	}
+
+	id, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse goroutine ID: %v", err))
+	}
+
+	return id
+}
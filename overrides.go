@@ -0,0 +1,301 @@
+//go:build assert
+
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// This file contains testify assertions modified to report structured
+// failures (see AssertionError). assertgen skips upstream declarations that
+// are defined in hand-written files such as this one.
+
+// Fail reports a failure through the current [FailureHandler] (see
+// [SetFailureHandler]). It returns false if the handler returns.
+func Fail(failureMessage string, msgAndArgs ...interface{}) bool {
+	return failWith(&AssertionError{Message: failureMessage}, msgAndArgs...)
+}
+
+// Equal asserts that two objects are equal.
+//
+//	assert.Equal(123, 123)
+//
+// Pointer variable equality is determined based on the equality of the
+// referenced values (as opposed to the memory addresses). Function equality
+// cannot be determined and will always fail.
+func Equal(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if err := validateEqualArgs(expected, actual); err != nil {
+		return Fail(fmt.Sprintf("Invalid operation: %#v == %#v (%s)",
+			expected, actual, err), msgAndArgs...)
+	}
+
+	if !ObjectsAreEqual(expected, actual) {
+		return failNotEqual("Not equal", expected, actual, msgAndArgs...)
+	}
+
+	return true
+
+}
+
+// failNotEqual reports that expected and actual are not equal. The failure
+// message starts with title and is followed by both values and their diff.
+func failNotEqual(title string, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	return failWith(notEqualError(title, expected, actual), msgAndArgs...)
+}
+
+// notEqualError returns an AssertionError whose message starts with title
+// and is followed by expected and actual values and their diff.
+func notEqualError(title string, expected, actual interface{}) *AssertionError {
+	diff := diff(expected, actual)
+	e, a := formatUnequalValues(expected, actual)
+	return &AssertionError{
+		Message: fmt.Sprintf(title+": \n"+
+			"expected: %s\n"+
+			"actual  : %s%s", e, a, diff),
+		Expected: expected,
+		Actual:   actual,
+		Diff:     strings.TrimPrefix(diff, "\n\nDiff:\n"),
+	}
+}
+
+// NotEqual asserts that the specified values are NOT equal.
+//
+//	assert.NotEqual(obj1, obj2)
+//
+// Pointer variable equality is determined based on the equality of the
+// referenced values (as opposed to the memory addresses).
+func NotEqual(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	if err := validateEqualArgs(expected, actual); err != nil {
+		return Fail(fmt.Sprintf("Invalid operation: %#v != %#v (%s)",
+			expected, actual, err), msgAndArgs...)
+	}
+
+	if ObjectsAreEqual(expected, actual) {
+		return failWith(&AssertionError{
+			Message:  fmt.Sprintf("Should not be: %#v\n", actual),
+			Expected: expected,
+			Actual:   actual,
+		}, msgAndArgs...)
+	}
+
+	return true
+}
+
+// EqualValues asserts that two objects are equal or convertible to the larger
+// type and equal.
+//
+//	assert.EqualValues(uint32(123), int32(123))
+func EqualValues(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+
+	if !ObjectsAreEqualValues(expected, actual) {
+		return failNotEqual("Not equal", expected, actual, msgAndArgs...)
+	}
+
+	return true
+
+}
+
+// EqualExportedValues asserts that the types of two objects are equal and their public
+// fields are also equal. This is useful for comparing structs that have private fields
+// that could potentially differ.
+//
+//	 type S struct {
+//		Exported     	int
+//		notExported   	int
+//	 }
+//	 assert.EqualExportedValues(S{1, 2}, S{1, 3}) => true
+//	 assert.EqualExportedValues(S{1, 2}, S{2, 3}) => false
+func EqualExportedValues(expected, actual interface{}, msgAndArgs ...interface{}) bool {
+
+	aType := reflect.TypeOf(expected)
+	bType := reflect.TypeOf(actual)
+
+	if aType != bType {
+		return Fail(fmt.Sprintf("Types expected to match exactly\n\t%v != %v", aType, bType), msgAndArgs...)
+	}
+
+	expected = copyExportedFields(expected)
+	actual = copyExportedFields(actual)
+
+	if !ObjectsAreEqualValues(expected, actual) {
+		return failNotEqual("Not equal (comparing only exported fields)", expected, actual, msgAndArgs...)
+	}
+
+	return true
+}
+
+// NoError asserts that a function returned no error (i.e. `nil`).
+//
+//	  actualObj, err := SomeFunction()
+//	  if assert.NoError(err) {
+//		   assert.Equal(expectedObj, actualObj)
+//	  }
+func NoError(err error, msgAndArgs ...interface{}) bool {
+	if err != nil {
+		return failWith(&AssertionError{
+			Message: fmt.Sprintf("Received unexpected error:\n%+v", err),
+			Err:     err,
+		}, msgAndArgs...)
+	}
+
+	return true
+}
+
+// ErrorIs asserts that at least one of the errors in err's chain matches target.
+// This is a wrapper for errors.Is.
+func ErrorIs(err, target error, msgAndArgs ...interface{}) bool {
+	if errors.Is(err, target) {
+		return true
+	}
+
+	var expectedText string
+	if target != nil {
+		expectedText = target.Error()
+	}
+
+	chain := buildErrorChainString(err)
+
+	return failWith(&AssertionError{
+		Message: fmt.Sprintf("Target error should be in err chain:\n"+
+			"expected: %q\n"+
+			"in chain: %s", expectedText, chain,
+		),
+		Expected: target,
+		Err:      err,
+	}, msgAndArgs...)
+}
+
+// NotErrorIs asserts that none of the errors in err's chain matches target.
+// This is a wrapper for errors.Is.
+func NotErrorIs(err, target error, msgAndArgs ...interface{}) bool {
+	if !errors.Is(err, target) {
+		return true
+	}
+
+	var expectedText string
+	if target != nil {
+		expectedText = target.Error()
+	}
+
+	chain := buildErrorChainString(err)
+
+	return failWith(&AssertionError{
+		Message: fmt.Sprintf("Target error should not be in err chain:\n"+
+			"found: %q\n"+
+			"in chain: %s", expectedText, chain,
+		),
+		Err: err,
+	}, msgAndArgs...)
+}
+
+// EqualError asserts that a function returned an error (i.e. not `nil`)
+// and that it is equal to the provided error.
+//
+//	actualObj, err := SomeFunction()
+//	assert.EqualError(err,  expectedErrorString)
+func EqualError(theError error, errString string, msgAndArgs ...interface{}) bool {
+	if !Error(theError, msgAndArgs...) {
+		return false
+	}
+	expected := errString
+	actual := theError.Error()
+	// don't need to use deep equals here, we know they are both strings
+	if expected != actual {
+		return failWith(&AssertionError{
+			Message: fmt.Sprintf("Error message not equal:\n"+
+				"expected: %q\n"+
+				"actual  : %q", expected, actual),
+			Expected: expected,
+			Actual:   actual,
+			Err:      theError,
+		}, msgAndArgs...)
+	}
+	return true
+}
+
+// ErrorContains asserts that a function returned an error (i.e. not `nil`)
+// and that the error contains the specified substring.
+//
+//	actualObj, err := SomeFunction()
+//	assert.ErrorContains(err,  expectedErrorSubString)
+func ErrorContains(theError error, contains string, msgAndArgs ...interface{}) bool {
+	if !Error(theError, msgAndArgs...) {
+		return false
+	}
+
+	actual := theError.Error()
+	if !strings.Contains(actual, contains) {
+		return failWith(&AssertionError{
+			Message: fmt.Sprintf("Error %#v does not contain %#v", actual, contains),
+			Err:     theError,
+		}, msgAndArgs...)
+	}
+
+	return true
+}
+
+// ErrorAs asserts that at least one of the errors in err's chain matches target, and if so, sets target to that error value.
+// This is a wrapper for errors.As.
+func ErrorAs(err error, target interface{}, msgAndArgs ...interface{}) bool {
+	if errors.As(err, target) {
+		return true
+	}
+
+	chain := buildErrorChainString(err)
+
+	return failWith(&AssertionError{
+		Message: fmt.Sprintf("Should be in error chain:\n"+
+			"expected: %q\n"+
+			"in chain: %s", target, chain,
+		),
+		Expected: target,
+		Err:      err,
+	}, msgAndArgs...)
+}
+
+// NotErrorAs asserts that none of the errors in err's chain matches target,
+// but if so, sets target to that error value.
+func NotErrorAs(err error, target interface{}, msgAndArgs ...interface{}) bool {
+	if !errors.As(err, target) {
+		return true
+	}
+
+	chain := buildErrorChainString(err)
+
+	return failWith(&AssertionError{
+		Message: fmt.Sprintf("Target error should not be in err chain:\n"+
+			"found: %q\n"+
+			"in chain: %s", target, chain,
+		),
+		Err: err,
+	}, msgAndArgs...)
+}
+
+// compareTwoValues is the implementation of ordered comparison assertions
+// such as [Less] and [Greater]. e1 is reported as the actual value and e2 as
+// the expected bound.
+func compareTwoValues(e1 interface{}, e2 interface{}, allowedComparesResults []compareResult, failMessage string, msgAndArgs ...interface{}) bool {
+	e1Kind := reflect.ValueOf(e1).Kind()
+	e2Kind := reflect.ValueOf(e2).Kind()
+	if e1Kind != e2Kind {
+		return Fail("Elements should be the same type", msgAndArgs...)
+	}
+
+	compareResult, isComparable := compare(e1, e2, e1Kind)
+	if !isComparable {
+		return Fail(fmt.Sprintf("Can not compare type \"%s\"", reflect.TypeOf(e1)), msgAndArgs...)
+	}
+
+	if !containsValue(allowedComparesResults, compareResult) {
+		return failWith(&AssertionError{
+			Message:  fmt.Sprintf(failMessage, e1, e2),
+			Expected: e2,
+			Actual:   e1,
+		}, msgAndArgs...)
+	}
+
+	return true
+}
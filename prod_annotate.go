@@ -0,0 +1,26 @@
+//go:build !assert
+
+package assert
+
+// With returns fields built from key/value pairs. When passed among the
+// msgAndArgs of an assertion, they are shown as labeled sections of its
+// failure report and stored in [AssertionError.Fields]:
+//
+//	assert.Equal(a, b, assert.With("tenant", id, "shard", s))
+//	assert.Equal(a, b, assert.With("tenant", id), "error message %s", "formatted")
+//
+// A value without key is labeled "!BADKEY".
+func With(kv ...interface{}) []Field { return nil }
+
+// Annotate adds fields built from key/value pairs (see [With]) to the
+// failure reports of assertions made by the calling goroutine until the
+// returned function is called. Nested annotations must be removed in reverse
+// order, which is the case when the returned function is deferred:
+//
+//	func (s *Server) handle(req *Request) {
+//		defer assert.Annotate("tenant", req.Tenant, "request", req.ID)()
+//		// ...
+//	}
+func Annotate(kv ...interface{}) (done func()) { return annotateDone }
+
+func annotateDone() {}
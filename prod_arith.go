@@ -0,0 +1,31 @@
+//go:build !assert
+
+package assert
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Add returns a + b. It asserts that the addition doesn't overflow.
+//
+//	total := assert.Add(total, n)
+func Add[T Integer](a, b T) T { return a + b }
+
+// Sub returns a - b. It asserts that the subtraction doesn't overflow (or
+// underflow for unsigned integers).
+//
+//	remaining := assert.Sub(capacity, used)
+func Sub[T Integer](a, b T) T { return a - b }
+
+// Mul returns a * b. It asserts that the multiplication doesn't overflow.
+//
+//	size := assert.Mul(count, elemSize)
+func Mul[T Integer](a, b T) T { return a * b }
+
+// Convert returns v converted to type To. It asserts that the conversion
+// doesn't truncate v nor change its sign.
+//
+//	n := assert.Convert[int32](len(s))
+func Convert[To, From Integer](v From) To { return To(v) }
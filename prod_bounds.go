@@ -0,0 +1,25 @@
+//go:build !assert
+
+package assert
+
+// Index returns s[i]. It asserts that i is within the bounds of s and reports
+// the index, length and capacity of s otherwise.
+//
+//	day := assert.Index(days, i)
+func Index[S ~[]E, E any](s S, i int) E { return s[i] }
+
+// Slice returns s[lo:hi]. It asserts that 0 <= lo <= hi <= cap(s) and reports
+// the bounds, length and capacity of s otherwise.
+//
+//	weekdays := assert.Slice(days, 0, 5)
+func Slice[S ~[]E, E any](s S, lo, hi int) S { return s[lo:hi] }
+
+// InBounds asserts that 0 <= i < length.
+//
+//	assert.InBounds(i, len(days))
+func InBounds(i, length int, msgAndArgs ...interface{}) bool { return true }
+
+// InBoundsf asserts that 0 <= i < length.
+//
+//	assert.InBoundsf(i, len(days), "error message %s", "formatted")
+func InBoundsf(i, length int, msg string, args ...interface{}) bool { return true }
@@ -0,0 +1,43 @@
+//go:build !assert
+
+package assert
+
+// Requires asserts that the precondition cond holds. Its failures are labeled
+// "Precondition" in the report.
+//
+//	assert.Requires(n > 0, "n must be positive")
+func Requires(cond bool, msgAndArgs ...interface{}) bool { return true }
+
+// Requiresf asserts that the precondition cond holds. Its failures are
+// labeled "Precondition" in the report.
+//
+//	assert.Requiresf(n > 0, "error message %s", "formatted")
+func Requiresf(cond bool, msg string, args ...interface{}) bool { return true }
+
+// Ensures asserts that the postcondition cond holds. It is meant to be
+// deferred at the beginning of a function so cond is evaluated when the
+// function returns. Its failures are labeled "Postcondition" in the report.
+//
+// Use [Old] to compare the state on return with the state on entry:
+//
+//	oldLen := assert.Old(len(q.items))
+//	defer assert.Ensures(func() bool { return len(q.items) == oldLen+1 })
+func Ensures(cond func() bool, msgAndArgs ...interface{}) bool { return true }
+
+// Ensuresf asserts that the postcondition cond holds. See [Ensures].
+//
+//	defer assert.Ensuresf(func() bool { return len(q.items) == oldLen+1 }, "error message %s", "formatted")
+func Ensuresf(cond func() bool, msg string, args ...interface{}) bool { return true }
+
+// Old returns a deep copy of v so postconditions (see [Ensures]) can compare
+// the state on return with the state on entry, even if v shares memory (e.g.
+// a slice or a map) modified by the function.
+//
+// When assertions are disabled, Old returns the zero value of T. Its result
+// must only be used in postconditions.
+//
+//	oldItems := assert.Old(q.items)
+func Old[T any](v T) T {
+	var zero T
+	return zero
+}
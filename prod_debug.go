@@ -0,0 +1,46 @@
+//go:build !assert
+
+package assert
+
+// Debug calls f only when assertions are enabled. It is meant for debug-only
+// bookkeeping such as updating [Shadow] state:
+//
+//	assert.Debug(func() {
+//		h.live[id] = struct{}{}
+//	})
+func Debug(f func()) {}
+
+// Shadow holds a value of type T only when assertions are enabled. It lets
+// structs carry debug-only state without changing their layout in production:
+// a Shadow is a zero-size struct when assertions are disabled. Go pads
+// structs ending with a zero-size field, so Shadow fields should not be the
+// last field of a struct.
+//
+// The zero value holds the zero value of T. A Shadow isn't safe for concurrent
+// use.
+//
+//	type Pool struct {
+//		generation assert.Shadow[int]
+//		items      []*Item
+//	}
+type Shadow[T any] struct {
+	value shadowValue[T]
+}
+
+type shadowValue[T any] struct{}
+
+// Get returns the value of s. It returns the zero value of T when assertions
+// are disabled.
+func (s *Shadow[T]) Get() T {
+	var zero T
+	return zero
+}
+
+// Set sets the value of s.
+func (s *Shadow[T]) Set(v T) {}
+
+// Update sets the value of s to the result of f called with the current
+// value. f isn't called when assertions are disabled.
+//
+//	p.generation.Update(func(gen int) int { return gen + 1 })
+func (s *Shadow[T]) Update(f func(T) T) {}
@@ -40,3 +40,60 @@ func Unlocked(locker TryLocker, msgAndArgs ...any) {}
 //	assert.IsDecreasing([]float{2, 1})
 //	assert.IsDecreasing([]string{"b", "a"})
 func Unlockedf(locker TryLocker, msg string, args ...any) {}
+
+// A TryRLocker represents a reader/writer lock that can attempt to acquire a
+// read lock and report whether it succeeded.
+//
+// [sync.RWMutex] and [RWMutex] implements this interface.
+type TryRLocker interface {
+	TryLocker
+	RLock()
+	RUnlock()
+	TryRLock() bool
+}
+
+// RLocked asserts that the [TryRLocker] is locked for reading.
+//
+// A [sync.RWMutex] locked for writing can't be distinguished from one locked
+// for reading with a pending writer, use [RWMutex] to detect it.
+//
+//	assert.RLocked(&rw)
+func RLocked(rw TryRLocker, msgAndArgs ...any) bool { return true }
+
+// RLockedf asserts that the [TryRLocker] is locked for reading.
+//
+// A [sync.RWMutex] locked for writing can't be distinguished from one locked
+// for reading with a pending writer, use [RWMutex] to detect it.
+//
+//	assert.RLockedf(&rw, "error message %s", "formatted")
+func RLockedf(rw TryRLocker, msg string, args ...any) bool { return true }
+
+// RUnlocked asserts that the [TryRLocker] isn't locked for reading. It may be
+// locked for writing.
+//
+// A [sync.RWMutex] locked for reading with a pending writer can't be
+// distinguished from one locked for writing, use [RWMutex] to detect it.
+//
+//	assert.RUnlocked(&rw)
+func RUnlocked(rw TryRLocker, msgAndArgs ...any) bool { return true }
+
+// RUnlockedf asserts that the [TryRLocker] isn't locked for reading. It may be
+// locked for writing.
+//
+// A [sync.RWMutex] locked for reading with a pending writer can't be
+// distinguished from one locked for writing, use [RWMutex] to detect it.
+//
+//	assert.RUnlockedf(&rw, "error message %s", "formatted")
+func RUnlockedf(rw TryRLocker, msg string, args ...any) bool { return true }
+
+// LockedAny asserts that the [TryRLocker] is locked for either reading or
+// writing.
+//
+//	assert.LockedAny(&rw)
+func LockedAny(rw TryRLocker, msgAndArgs ...any) bool { return true }
+
+// LockedAnyf asserts that the [TryRLocker] is locked for either reading or
+// writing.
+//
+//	assert.LockedAnyf(&rw, "error message %s", "formatted")
+func LockedAnyf(rw TryRLocker, msg string, args ...any) bool { return true }
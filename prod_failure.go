@@ -0,0 +1,74 @@
+//go:build !assert
+
+package assert
+
+// AssertionError describes a failed assertion. It is the error passed to the
+// [FailureHandler], and thus the panic value of failed assertions when the
+// default [PanicHandler] is used.
+type AssertionError struct {
+	// Assertion is the name of the failed assertion function (e.g. "Equal").
+	Assertion string
+	// Kind is the failure category (e.g. "Precondition") used to label
+	// Message in the report. It is empty for regular assertions whose message
+	// is labeled "Error".
+	Kind string
+	// Message describes the failure.
+	Message string
+	// Expression is the source code of the failed assertion call (e.g.
+	// "assert.True(len(q.items) <= q.cap)"). It is empty if the source file
+	// isn't available at runtime.
+	Expression string
+	// UserMessage is the optional message built from msgAndArgs.
+	UserMessage string
+	// Trace contains the file and line number of each caller frame leading to
+	// the failed assertion, as returned by [CallerInfo]. Entries are rendered
+	// according to [TraceOptions] and may span multiple lines.
+	Trace []string
+	// Expected and Actual are the compared values of assertions such as
+	// [Equal]. They're nil when not applicable.
+	Expected, Actual interface{}
+	// Diff is the unified diff of Expected and Actual, if any.
+	Diff string
+	// Err is the error checked by assertions such as [NoError] or [ErrorIs].
+	Err error
+	// Fields contains additional labeled values of the report: fields of the
+	// [Report], fields passed with [With] and goroutine annotations (see
+	// [Annotate]).
+	Fields []Field
+}
+
+// Error implements the error interface. It returns the labeled report of the
+// failure.
+func (e *AssertionError) Error() string { return e.Message }
+
+// Unwrap returns the error checked by the failed assertion, if any.
+func (e *AssertionError) Unwrap() error { return e.Err }
+
+// A FailureHandler is called by Fail each time an assertion fails. err
+// describes the failure.
+//
+// If the handler returns, the assertion returns false and the program
+// continues its execution.
+type FailureHandler func(err error)
+
+// SetFailureHandler sets the handler called on assertion failures and returns
+// the previous one. A nil handler restores the default [PanicHandler].
+//
+// It is safe to call SetFailureHandler concurrently with failing assertions.
+func SetFailureHandler(h FailureHandler) FailureHandler { return nil }
+
+// PanicHandler is the default [FailureHandler], it panics with err.
+func PanicHandler(err error) {}
+
+// LogHandler is a [FailureHandler] that logs err using the standard logger
+// and lets the program continue.
+func LogHandler(err error) {}
+
+// ExitHandler returns a [FailureHandler] that prints err to stderr and exits
+// the program with the given status code.
+func ExitHandler(code int) FailureHandler { return nil }
+
+// BreakpointHandler is a [FailureHandler] that prints err to stderr and
+// executes a breakpoint trap so an attached debugger stops right where the
+// assertion failed.
+func BreakpointHandler(err error) {}
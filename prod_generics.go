@@ -0,0 +1,123 @@
+//go:build !assert
+
+package assert
+
+import "cmp"
+
+// EqualT asserts that two values of the same type are equal. Unlike [Equal],
+// mismatched types are reported at compile time and comparison doesn't rely
+// on reflection.
+//
+//	assert.EqualT(123, 123)
+func EqualT[T comparable](expected, actual T, msgAndArgs ...interface{}) bool { return true }
+
+// EqualTf asserts that two values of the same type are equal. Unlike
+// [Equalf], mismatched types are reported at compile time and comparison
+// doesn't rely on reflection.
+//
+//	assert.EqualTf(123, 123, "error message %s", "formatted")
+func EqualTf[T comparable](expected, actual T, msg string, args ...interface{}) bool { return true }
+
+// NotEqualT asserts that two values of the same type are not equal.
+//
+//	assert.NotEqualT(obj1, obj2)
+func NotEqualT[T comparable](expected, actual T, msgAndArgs ...interface{}) bool { return true }
+
+// NotEqualTf asserts that two values of the same type are not equal.
+//
+//	assert.NotEqualTf(obj1, obj2, "error message %s", "formatted")
+func NotEqualTf[T comparable](expected, actual T, msg string, args ...interface{}) bool { return true }
+
+// LessT asserts that the first element is less than the second.
+//
+//	assert.LessT(1, 2)
+//	assert.LessT("a", "b")
+func LessT[T cmp.Ordered](e1, e2 T, msgAndArgs ...interface{}) bool { return true }
+
+// LessTf asserts that the first element is less than the second.
+//
+//	assert.LessTf(1, 2, "error message %s", "formatted")
+func LessTf[T cmp.Ordered](e1, e2 T, msg string, args ...interface{}) bool { return true }
+
+// LessOrEqualT asserts that the first element is less than or equal to the
+// second.
+//
+//	assert.LessOrEqualT(1, 2)
+//	assert.LessOrEqualT(2, 2)
+func LessOrEqualT[T cmp.Ordered](e1, e2 T, msgAndArgs ...interface{}) bool { return true }
+
+// LessOrEqualTf asserts that the first element is less than or equal to the
+// second.
+//
+//	assert.LessOrEqualTf(1, 2, "error message %s", "formatted")
+func LessOrEqualTf[T cmp.Ordered](e1, e2 T, msg string, args ...interface{}) bool { return true }
+
+// GreaterT asserts that the first element is greater than the second.
+//
+//	assert.GreaterT(2, 1)
+//	assert.GreaterT("b", "a")
+func GreaterT[T cmp.Ordered](e1, e2 T, msgAndArgs ...interface{}) bool { return true }
+
+// GreaterTf asserts that the first element is greater than the second.
+//
+//	assert.GreaterTf(2, 1, "error message %s", "formatted")
+func GreaterTf[T cmp.Ordered](e1, e2 T, msg string, args ...interface{}) bool { return true }
+
+// GreaterOrEqualT asserts that the first element is greater than or equal to
+// the second.
+//
+//	assert.GreaterOrEqualT(2, 1)
+//	assert.GreaterOrEqualT(2, 2)
+func GreaterOrEqualT[T cmp.Ordered](e1, e2 T, msgAndArgs ...interface{}) bool { return true }
+
+// GreaterOrEqualTf asserts that the first element is greater than or equal to
+// the second.
+//
+//	assert.GreaterOrEqualTf(2, 1, "error message %s", "formatted")
+func GreaterOrEqualTf[T cmp.Ordered](e1, e2 T, msg string, args ...interface{}) bool { return true }
+
+// ContainsT asserts that the specified slice contains the specified element.
+//
+//	assert.ContainsT([]string{"Hello", "World"}, "World")
+func ContainsT[S ~[]E, E comparable](s S, element E, msgAndArgs ...interface{}) bool { return true }
+
+// ContainsTf asserts that the specified slice contains the specified element.
+//
+//	assert.ContainsTf([]string{"Hello", "World"}, "World", "error message %s", "formatted")
+func ContainsTf[S ~[]E, E comparable](s S, element E, msg string, args ...interface{}) bool {
+	return true
+}
+
+// NotContainsT asserts that the specified slice does not contain the
+// specified element.
+//
+//	assert.NotContainsT([]string{"Hello", "World"}, "Earth")
+func NotContainsT[S ~[]E, E comparable](s S, element E, msgAndArgs ...interface{}) bool { return true }
+
+// NotContainsTf asserts that the specified slice does not contain the
+// specified element.
+//
+//	assert.NotContainsTf([]string{"Hello", "World"}, "Earth", "error message %s", "formatted")
+func NotContainsTf[S ~[]E, E comparable](s S, element E, msg string, args ...interface{}) bool {
+	return true
+}
+
+// NilPtr asserts that the specified pointer is nil.
+//
+//	assert.NilPtr(ptr)
+func NilPtr[T any](ptr *T, msgAndArgs ...interface{}) bool { return true }
+
+// NilPtrf asserts that the specified pointer is nil.
+//
+//	assert.NilPtrf(ptr, "error message %s", "formatted")
+func NilPtrf[T any](ptr *T, msg string, args ...interface{}) bool { return true }
+
+// NotNilPtr asserts that the specified pointer is not nil.
+//
+//	assert.NotNilPtr(ptr)
+func NotNilPtr[T any](ptr *T, msgAndArgs ...interface{}) bool { return true }
+
+// NotNilPtrf asserts that the specified pointer is not nil.
+//
+//	assert.NotNilPtrf(ptr, "error message %s", "formatted")
+func NotNilPtrf[T any](ptr *T, msg string, args ...interface{}) bool { return true }
@@ -0,0 +1,26 @@
+//go:build !assert
+
+package assert
+
+// Invariant is implemented by types that check their own invariants. See
+// [Valid].
+type Invariant interface {
+	// CheckInvariant returns an error describing the first broken invariant,
+	// if any.
+	CheckInvariant() error
+}
+
+// Valid asserts that v and every value reachable from its exported struct
+// fields, slice and array elements, map keys and values implementing
+// [Invariant] satisfy their invariants. The path of the first violation (e.g.
+// "root.Items[3].Owner") is reported as well as the error returned by
+// CheckInvariant.
+//
+//	assert.Valid(order)
+func Valid(v interface{}, msgAndArgs ...interface{}) bool { return true }
+
+// Validf asserts that v and every value reachable from it satisfy their
+// invariants. See [Valid].
+//
+//	assert.Validf(order, "error message %s", "formatted")
+func Validf(v interface{}, msg string, args ...interface{}) bool { return true }
@@ -0,0 +1,24 @@
+//go:build !assert
+
+package assert
+
+// That asserts that cond returns true. Unlike [True], cond is not evaluated
+// when assertions are disabled so it may contain expensive computations.
+//
+//	assert.That(func() bool { return len(q.items) <= q.cap })
+func That(cond func() bool, msgAndArgs ...interface{}) bool { return true }
+
+// Thatf asserts that cond returns true. Unlike [Truef], cond is not evaluated
+// when assertions are disabled so it may contain expensive computations.
+//
+//	assert.Thatf(func() bool { return len(q.items) <= q.cap }, "error message %s", "formatted")
+func Thatf(cond func() bool, msg string, args ...interface{}) bool { return true }
+
+// Lazy calls f only when assertions are enabled. It is useful to group
+// assertions whose arguments are expensive to compute:
+//
+//	assert.Lazy(func() {
+//		assert.Len(buildSlice(), n)
+//		assert.Equal(expensive(), x)
+//	})
+func Lazy(f func()) {}
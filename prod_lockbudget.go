@@ -0,0 +1,28 @@
+//go:build !assert
+
+package assert
+
+import "time"
+
+// A LockBudget defines time limits of a [Mutex] or [RWMutex]. A zero duration
+// disables the corresponding limit.
+type LockBudget struct {
+	// Hold is the maximum duration the (write) lock may be held.
+	Hold time.Duration
+	// Wait is the maximum duration a goroutine may block acquiring the lock.
+	Wait time.Duration
+}
+
+// SetDefaultLockBudget sets the budget of [Mutex] and [RWMutex] without one
+// (see [Mutex.SetBudget]) and returns the previous default budget. The zero
+// LockBudget, which is the default, disables budgets.
+func SetDefaultLockBudget(b LockBudget) LockBudget { return LockBudget{} }
+
+// SetBudget sets the budget of m. Locks held or waited for longer than the
+// budget are reported through [Fail] along with the stack of the acquisition.
+// SetBudget must be called before m is used.
+func (m *Mutex) SetBudget(b LockBudget) {}
+
+// SetBudget sets the budget of rw. Hold limit applies to the write lock and
+// to each read lock. See [Mutex.SetBudget].
+func (rw *RWMutex) SetBudget(b LockBudget) {}
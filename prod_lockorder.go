@@ -0,0 +1,16 @@
+//go:build !assert
+
+package assert
+
+// SetLockOrderChecks enables or disables lock order checks and returns the
+// previous setting. Lock order checks are disabled by default.
+//
+// When enabled, [Mutex] and [RWMutex] record the order in which each goroutine
+// acquires them in a global lock graph. The first time an acquisition creates
+// a cycle in the graph (e.g. a goroutine locks A then B while another locks B
+// then A), a potential deadlock is reported through [Fail] with the stacks of
+// both acquisitions, even if the program didn't actually deadlock.
+//
+// Locks are identified by their address and stay in the graph forever, so
+// mutexes tracked while checks are enabled are never garbage collected.
+func SetLockOrderChecks(enabled bool) bool { return false }
@@ -0,0 +1,98 @@
+//go:build !assert
+
+package assert
+
+import "sync"
+
+// An OwnedLocker is a [sync.Locker] that records which goroutine holds it.
+//
+// [Mutex] and [RWMutex] implements this interface.
+type OwnedLocker interface {
+	sync.Locker
+	lockState() *mutexState
+}
+
+// mutexState is empty when assertions are disabled.
+type mutexState struct{}
+
+// A Mutex is a [sync.Mutex] that records the goroutine holding it when
+// assertions are enabled. It reports recursive locking, unlocking of an
+// unlocked mutex and unlocking by another goroutine through [Fail]. It is a
+// plain [sync.Mutex] otherwise.
+//
+// A Mutex must not be copied after first use.
+type Mutex struct {
+	state mutexState
+	mu    sync.Mutex
+}
+
+// Lock locks m. See [sync.Mutex.Lock].
+func (m *Mutex) Lock() { m.mu.Lock() }
+
+// TryLock tries to lock m and reports whether it succeeded. See
+// [sync.Mutex.TryLock].
+func (m *Mutex) TryLock() bool { return m.mu.TryLock() }
+
+// Unlock unlocks m. See [sync.Mutex.Unlock].
+func (m *Mutex) Unlock() { m.mu.Unlock() }
+
+func (m *Mutex) lockState() *mutexState { return &m.state }
+
+// A RWMutex is a [sync.RWMutex] that records the goroutine holding the
+// write lock when assertions are enabled. See [Mutex].
+//
+// A RWMutex must not be copied after first use.
+type RWMutex struct {
+	state mutexState
+	mu    sync.RWMutex
+}
+
+// Lock locks rw for writing. See [sync.RWMutex.Lock].
+func (rw *RWMutex) Lock() { rw.mu.Lock() }
+
+// TryLock tries to lock rw for writing and reports whether it succeeded. See
+// [sync.RWMutex.TryLock].
+func (rw *RWMutex) TryLock() bool { return rw.mu.TryLock() }
+
+// Unlock unlocks rw for writing. See [sync.RWMutex.Unlock].
+func (rw *RWMutex) Unlock() { rw.mu.Unlock() }
+
+// RLock locks rw for reading. See [sync.RWMutex.RLock].
+func (rw *RWMutex) RLock() { rw.mu.RLock() }
+
+// TryRLock tries to lock rw for reading and reports whether it succeeded. See
+// [sync.RWMutex.TryRLock].
+func (rw *RWMutex) TryRLock() bool { return rw.mu.TryRLock() }
+
+// RUnlock undoes a single [RWMutex.RLock] call. See [sync.RWMutex.RUnlock].
+func (rw *RWMutex) RUnlock() { rw.mu.RUnlock() }
+
+// RLocker returns a [sync.Locker] interface that implements the Lock and
+// Unlock methods by calling rw.RLock and rw.RUnlock.
+func (rw *RWMutex) RLocker() sync.Locker { return rw.mu.RLocker() }
+
+func (rw *RWMutex) lockState() *mutexState { return &rw.state }
+
+// LockedByCaller asserts that the [OwnedLocker] is locked by the calling
+// goroutine.
+//
+//	assert.LockedByCaller(&mu)
+func LockedByCaller(locker OwnedLocker, msgAndArgs ...interface{}) bool { return true }
+
+// LockedByCallerf asserts that the [OwnedLocker] is locked by the calling
+// goroutine.
+//
+//	assert.LockedByCallerf(&mu, "error message %s", "formatted")
+func LockedByCallerf(locker OwnedLocker, msg string, args ...interface{}) bool { return true }
+
+// NotLockedByCaller asserts that the [OwnedLocker] isn't locked by the
+// calling goroutine. It may be locked by another goroutine.
+//
+//	assert.NotLockedByCaller(&mu)
+func NotLockedByCaller(locker OwnedLocker, msgAndArgs ...interface{}) bool { return true }
+
+// NotLockedByCallerf asserts that the [OwnedLocker] isn't locked by the
+// calling goroutine. It may be locked by another goroutine.
+//
+//	assert.NotLockedByCallerf(&mu, "error message %s", "formatted")
+func NotLockedByCallerf(locker OwnedLocker, msg string, args ...interface{}) bool { return true }
@@ -0,0 +1,80 @@
+//go:build !assert
+
+package assert
+
+// Fail reports a failure through the current [FailureHandler] (see
+// [SetFailureHandler]). It returns false if the handler returns.
+func Fail(failureMessage string, msgAndArgs ...interface{}) bool { return true }
+
+// Equal asserts that two objects are equal.
+//
+//	assert.Equal(123, 123)
+//
+// Pointer variable equality is determined based on the equality of the
+// referenced values (as opposed to the memory addresses). Function equality
+// cannot be determined and will always fail.
+func Equal(expected, actual interface{}, msgAndArgs ...interface{}) bool { return true }
+
+// NotEqual asserts that the specified values are NOT equal.
+//
+//	assert.NotEqual(obj1, obj2)
+//
+// Pointer variable equality is determined based on the equality of the
+// referenced values (as opposed to the memory addresses).
+func NotEqual(expected, actual interface{}, msgAndArgs ...interface{}) bool { return true }
+
+// EqualValues asserts that two objects are equal or convertible to the larger
+// type and equal.
+//
+//	assert.EqualValues(uint32(123), int32(123))
+func EqualValues(expected, actual interface{}, msgAndArgs ...interface{}) bool { return true }
+
+// EqualExportedValues asserts that the types of two objects are equal and their public
+// fields are also equal. This is useful for comparing structs that have private fields
+// that could potentially differ.
+//
+//	 type S struct {
+//		Exported     	int
+//		notExported   	int
+//	 }
+//	 assert.EqualExportedValues(S{1, 2}, S{1, 3}) => true
+//	 assert.EqualExportedValues(S{1, 2}, S{2, 3}) => false
+func EqualExportedValues(expected, actual interface{}, msgAndArgs ...interface{}) bool { return true }
+
+// NoError asserts that a function returned no error (i.e. `nil`).
+//
+//	  actualObj, err := SomeFunction()
+//	  if assert.NoError(err) {
+//		   assert.Equal(expectedObj, actualObj)
+//	  }
+func NoError(err error, msgAndArgs ...interface{}) bool { return true }
+
+// ErrorIs asserts that at least one of the errors in err's chain matches target.
+// This is a wrapper for errors.Is.
+func ErrorIs(err, target error, msgAndArgs ...interface{}) bool { return true }
+
+// NotErrorIs asserts that none of the errors in err's chain matches target.
+// This is a wrapper for errors.Is.
+func NotErrorIs(err, target error, msgAndArgs ...interface{}) bool { return true }
+
+// EqualError asserts that a function returned an error (i.e. not `nil`)
+// and that it is equal to the provided error.
+//
+//	actualObj, err := SomeFunction()
+//	assert.EqualError(err,  expectedErrorString)
+func EqualError(theError error, errString string, msgAndArgs ...interface{}) bool { return true }
+
+// ErrorContains asserts that a function returned an error (i.e. not `nil`)
+// and that the error contains the specified substring.
+//
+//	actualObj, err := SomeFunction()
+//	assert.ErrorContains(err,  expectedErrorSubString)
+func ErrorContains(theError error, contains string, msgAndArgs ...interface{}) bool { return true }
+
+// ErrorAs asserts that at least one of the errors in err's chain matches target, and if so, sets target to that error value.
+// This is a wrapper for errors.As.
+func ErrorAs(err error, target interface{}, msgAndArgs ...interface{}) bool { return true }
+
+// NotErrorAs asserts that none of the errors in err's chain matches target,
+// but if so, sets target to that error value.
+func NotErrorAs(err error, target interface{}, msgAndArgs ...interface{}) bool { return true }
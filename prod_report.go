@@ -0,0 +1,49 @@
+//go:build !assert
+
+package assert
+
+// A Field is a labeled value of a failure report.
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// A Report describes the failure of a user-defined assertion. See
+// [FailReport].
+type Report struct {
+	// Name is the name of the assertion reported in [AssertionError.Assertion].
+	// It defaults to the name of the outermost function marked with [Helper]
+	// that called FailReport.
+	Name string
+	// Kind is the label of Message in the report. It defaults to "Error".
+	Kind string
+	// Message describes the failure.
+	Message string
+	// Expected and Actual are the compared values, if any. When one of them
+	// isn't nil, they are appended to Message along with their diff like
+	// [Equal] does.
+	Expected, Actual interface{}
+	// Err is the error checked by the assertion, if any.
+	Err error
+	// Fields are additional labeled values shown after Message.
+	Fields []Field
+}
+
+// FailReport reports the failure of a user-defined assertion through the
+// current [FailureHandler]. It returns false if the handler returns.
+//
+// Custom assertions should call [Helper] so the trace and the source
+// expression of the report point to the call of the assertion rather than
+// its body:
+//
+//	func ValidUser(u *User, msgAndArgs ...interface{}) bool {
+//		assert.Helper()
+//		if u.Name == "" {
+//			return assert.FailReport(assert.Report{
+//				Message: "User has no name",
+//				Fields:  []assert.Field{{"ID", u.ID}},
+//			}, msgAndArgs...)
+//		}
+//		return true
+//	}
+func FailReport(r Report, msgAndArgs ...interface{}) bool { return true }
@@ -0,0 +1,47 @@
+//go:build !assert
+
+package assert
+
+// SetSameResultSampling makes [SameResult] and [SameResult2] check only 1 in
+// n calls and returns the previous setting. Other calls only run the fast
+// implementation. The default, 1, checks every call.
+func SetSameResultSampling(n int) int { return 1 }
+
+// SameResult runs fast and asserts that its result is equal to the result of
+// the reference implementation. It returns the result of fast. When
+// assertions are disabled, only fast is called.
+//
+// See [SetSameResultSampling] to check only a fraction of the calls.
+//
+//	sum := assert.SameResult(
+//		func() int { return simdSum(values) },
+//		func() int { return naiveSum(values) },
+//	)
+func SameResult[T any](fast, reference func() T, msgAndArgs ...interface{}) T { return fast() }
+
+// SameResultf runs fast and asserts that its result is equal to the result
+// of the reference implementation. See [SameResult].
+//
+//	sum := assert.SameResultf(fastSum, naiveSum, "error message %s", "formatted")
+func SameResultf[T any](fast, reference func() T, msg string, args ...interface{}) T {
+	return fast()
+}
+
+// SameResult2 is like [SameResult] for functions returning two values (e.g. a
+// value and an error).
+//
+//	v, err := assert.SameResult2(
+//		func() (int, error) { return fastParse(s) },
+//		func() (int, error) { return strconv.Atoi(s) },
+//	)
+func SameResult2[T, U any](fast, reference func() (T, U), msgAndArgs ...interface{}) (T, U) {
+	return fast()
+}
+
+// SameResult2f is like [SameResultf] for functions returning two values (e.g.
+// a value and an error).
+//
+//	v, err := assert.SameResult2f(fastParse, slowParse, "error message %s", "formatted")
+func SameResult2f[T, U any](fast, reference func() (T, U), msg string, args ...interface{}) (T, U) {
+	return fast()
+}
@@ -0,0 +1,32 @@
+//go:build !assert
+
+package assert
+
+// Struct asserts that the exported fields of the struct (or pointer to struct)
+// v satisfy the constraints of their `assert` tag. Nested structs are checked
+// too. All violated constraints are reported at once.
+//
+// A tag contains comma-separated constraints:
+//
+//	nonzero         field isn't empty (see [NotEmpty])
+//	len=N           length of the field is N, other operators are !=, <, <=, > and >=
+//	min=X, max=X    field is greater/less than or equal to X (numbers and strings)
+//	oneof=a b c     field formatted with %v is one of the space-separated values
+//	regexp=RE       field matches RE, it must be the last constraint of the tag
+//
+// For example:
+//
+//	type User struct {
+//		Name string `assert:"nonzero,len<=64"`
+//		Age  int    `assert:"min=1,max=100"`
+//		Role string `assert:"oneof=admin user"`
+//	}
+//
+//	assert.Struct(user)
+func Struct(v interface{}, msgAndArgs ...interface{}) bool { return true }
+
+// Structf asserts that the exported fields of the struct (or pointer to
+// struct) v satisfy the constraints of their `assert` tag. See [Struct].
+//
+//	assert.Structf(user, "error message %s", "formatted")
+func Structf(v interface{}, msg string, args ...interface{}) bool { return true }
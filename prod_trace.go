@@ -0,0 +1,47 @@
+//go:build !assert
+
+package assert
+
+// TraceOptions controls how caller frames are rendered in the "Error Trace"
+// section of failure reports and in [AssertionError.Trace]. The zero
+// TraceOptions, which is the default, renders frames as absolute
+// "file:line" strings like [CallerInfo].
+type TraceOptions struct {
+	// Functions appends the function name to each frame.
+	Functions bool
+	// Context is the number of source lines shown before and after the line
+	// of each frame. The line of the frame is marked with ">". Zero disables
+	// source snippets.
+	Context int
+	// RelativePaths renders paths relative to the root of their module (the
+	// directory containing go.mod) and standard library paths relative to
+	// GOROOT/src.
+	RelativePaths bool
+	// CollapseRuntime replaces consecutive standard library frames (runtime,
+	// reflect, sync...) by a single line.
+	CollapseRuntime bool
+}
+
+// SetTraceOptions sets the options used to render caller frames of failure
+// reports and returns the previous ones.
+//
+//	assert.SetTraceOptions(assert.TraceOptions{
+//		Functions:       true,
+//		Context:         2,
+//		RelativePaths:   true,
+//		CollapseRuntime: true,
+//	})
+func SetTraceOptions(o TraceOptions) TraceOptions { return TraceOptions{} }
+
+// Helper marks the calling function as a helper function: its frames are
+// omitted from [CallerInfo] and from the "Error Trace" of failure reports,
+// like frames of this package, and [AssertionError.Expression] is the call to
+// the outermost helper. Helper may be called concurrently and repeatedly from
+// the same function.
+//
+//	func requireValidUser(u *User) {
+//		assert.Helper()
+//		assert.NotEmpty(u.Name)
+//		assert.Positive(u.Age)
+//	}
+func Helper() {}
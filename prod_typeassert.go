@@ -0,0 +1,17 @@
+//go:build !assert
+
+package assert
+
+// As returns v.(T). It asserts that v holds a value of type T and reports its
+// dynamic type otherwise. If T is an interface type, As also asserts that v
+// isn't a typed nil (e.g. a nil *MyError stored in an error).
+//
+//	err := assert.As[*MyError](err)
+func As[T any](v any) T { return v.(T) }
+
+// Deref returns *p. It asserts that p isn't nil. If T is an interface type,
+// Deref also asserts that *p isn't a typed nil (e.g. a nil *MyError stored in
+// an error).
+//
+//	cfg := assert.Deref(cfgPtr)
+func Deref[T any](p *T) T { return *p }
@@ -0,0 +1,45 @@
+//go:build !assert
+
+package assert
+
+// Unreachable reports that code that must never execute was reached. Its
+// failures are labeled "Unreachable" in the report.
+//
+//	assert.Unreachable("handled by caller")
+func Unreachable(msgAndArgs ...interface{}) bool { return true }
+
+// Unreachablef reports that code that must never execute was reached. Its
+// failures are labeled "Unreachable" in the report.
+//
+//	assert.Unreachablef("error message %s", "formatted")
+func Unreachablef(msg string, args ...interface{}) bool { return true }
+
+// Todo reports that code that isn't implemented yet was reached. Its failures
+// are labeled "Todo" in the report.
+//
+//	assert.Todo("support IPv6")
+func Todo(msgAndArgs ...interface{}) bool { return true }
+
+// Todof reports that code that isn't implemented yet was reached. Its
+// failures are labeled "Todo" in the report.
+//
+//	assert.Todof("error message %s", "formatted")
+func Todof(msg string, args ...interface{}) bool { return true }
+
+// UnknownEnum reports that v isn't a known value of its enum type. It is meant
+// to be called in the default case of switches over enums. Its failures are
+// labeled "Unknown enum" in the report.
+//
+//	switch color {
+//	case Red, Green, Blue:
+//		// ...
+//	default:
+//		assert.UnknownEnum(color)
+//	}
+func UnknownEnum(v interface{}, msgAndArgs ...interface{}) bool { return true }
+
+// UnknownEnumf reports that v isn't a known value of its enum type. See
+// [UnknownEnum].
+//
+//	assert.UnknownEnumf(color, "error message %s", "formatted")
+func UnknownEnumf(v interface{}, msg string, args ...interface{}) bool { return true }
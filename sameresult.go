@@ -0,0 +1,96 @@
+//go:build assert
+
+package assert
+
+import "sync/atomic"
+
+var (
+	sameResultSampling atomic.Int64
+	sameResultCalls    atomic.Int64
+)
+
+// SetSameResultSampling makes [SameResult] and [SameResult2] check only 1 in
+// n calls and returns the previous setting. Other calls only run the fast
+// implementation. The default, 1, checks every call.
+func SetSameResultSampling(n int) int {
+	if n < 1 {
+		n = 1
+	}
+
+	prev := sameResultSampling.Swap(int64(n))
+	if prev == 0 {
+		return 1
+	}
+	return int(prev)
+}
+
+// sampleSameResult reports whether the current call of SameResult must be
+// checked.
+func sampleSameResult() bool {
+	n := sameResultSampling.Load()
+	if n <= 1 {
+		return true
+	}
+	return sameResultCalls.Add(1)%n == 0
+}
+
+// SameResult runs fast and asserts that its result is equal to the result of
+// the reference implementation. It returns the result of fast. When
+// assertions are disabled, only fast is called.
+//
+// See [SetSameResultSampling] to check only a fraction of the calls.
+//
+//	sum := assert.SameResult(
+//		func() int { return simdSum(values) },
+//		func() int { return naiveSum(values) },
+//	)
+func SameResult[T any](fast, reference func() T, msgAndArgs ...interface{}) T {
+	result := fast()
+	if !sampleSameResult() {
+		return result
+	}
+
+	if expected := reference(); !ObjectsAreEqual(expected, result) {
+		failNotEqual("Fast and reference results differ", expected, result, msgAndArgs...)
+	}
+
+	return result
+}
+
+// SameResultf runs fast and asserts that its result is equal to the result
+// of the reference implementation. See [SameResult].
+//
+//	sum := assert.SameResultf(fastSum, naiveSum, "error message %s", "formatted")
+func SameResultf[T any](fast, reference func() T, msg string, args ...interface{}) T {
+	return SameResult(fast, reference, append([]interface{}{msg}, args...)...)
+}
+
+// SameResult2 is like [SameResult] for functions returning two values (e.g. a
+// value and an error).
+//
+//	v, err := assert.SameResult2(
+//		func() (int, error) { return fastParse(s) },
+//		func() (int, error) { return strconv.Atoi(s) },
+//	)
+func SameResult2[T, U any](fast, reference func() (T, U), msgAndArgs ...interface{}) (T, U) {
+	r1, r2 := fast()
+	if !sampleSameResult() {
+		return r1, r2
+	}
+
+	e1, e2 := reference()
+	expected, actual := []interface{}{e1, e2}, []interface{}{r1, r2}
+	if !ObjectsAreEqual(expected, actual) {
+		failNotEqual("Fast and reference results differ", expected, actual, msgAndArgs...)
+	}
+
+	return r1, r2
+}
+
+// SameResult2f is like [SameResultf] for functions returning two values (e.g.
+// a value and an error).
+//
+//	v, err := assert.SameResult2f(fastParse, slowParse, "error message %s", "formatted")
+func SameResult2f[T, U any](fast, reference func() (T, U), msg string, args ...interface{}) (T, U) {
+	return SameResult2(fast, reference, append([]interface{}{msg}, args...)...)
+}
@@ -0,0 +1,102 @@
+//go:build assert
+
+package assert
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// sourceFile is a parsed source file.
+type sourceFile struct {
+	fset *token.FileSet
+	file *ast.File
+	src  []byte
+}
+
+// sourceFiles caches parsed source files by path. Files that can't be read or
+// parsed are cached as nil.
+var sourceFiles sync.Map
+
+// loadSourceFile returns the parsed source file at path or nil.
+func loadSourceFile(path string) *sourceFile {
+	if f, ok := sourceFiles.Load(path); ok {
+		return f.(*sourceFile)
+	}
+
+	var sf *sourceFile
+	if src, err := os.ReadFile(path); err == nil {
+		fset := token.NewFileSet()
+		if file, err := parser.ParseFile(fset, path, src, parser.SkipObjectResolution); err == nil {
+			sf = &sourceFile{fset: fset, file: file, src: src}
+		}
+	}
+
+	f, _ := sourceFiles.LoadOrStore(path, sf)
+	return f.(*sourceFile)
+}
+
+// sourceExpr returns the source code of the call to the given assertion made
+// by frame. It returns an empty string if the source file isn't available or
+// the call can't be found.
+func sourceExpr(frame runtime.Frame, assertion string) string {
+	if frame.File == "" || assertion == "" {
+		return ""
+	}
+	sf := loadSourceFile(frame.File)
+	if sf == nil {
+		return ""
+	}
+
+	// Method name of assertions such as "(*Mutex).Unlock".
+	if i := strings.LastIndexByte(assertion, '.'); i >= 0 {
+		assertion = assertion[i+1:]
+	}
+
+	// Find the innermost call to the assertion spanning frame line.
+	var call *ast.CallExpr
+	ast.Inspect(sf.file, func(n ast.Node) bool {
+		if n == nil {
+			return false
+		}
+		if sf.fset.Position(n.Pos()).Line > frame.Line || sf.fset.Position(n.End()).Line < frame.Line {
+			return false
+		}
+		if c, ok := n.(*ast.CallExpr); ok && calledName(c) == assertion {
+			call = c
+		}
+		return true
+	})
+	if call == nil {
+		return ""
+	}
+
+	start, end := sf.fset.Position(call.Pos()).Offset, sf.fset.Position(call.End()).Offset
+	return string(sf.src[start:end])
+}
+
+// calledName returns the name of the function or method called by call.
+func calledName(call *ast.CallExpr) string {
+	fun := ast.Unparen(call.Fun)
+	// Instantiated generic function (e.g. assert.As[int]).
+	switch f := fun.(type) {
+	case *ast.IndexExpr:
+		fun = f.X
+	case *ast.IndexListExpr:
+		fun = f.X
+	}
+
+	switch f := fun.(type) {
+	case *ast.Ident:
+		return f.Name
+	case *ast.SelectorExpr:
+		return f.Sel.Name
+	}
+
+	return ""
+}
@@ -0,0 +1,307 @@
+//go:build assert
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Struct asserts that the exported fields of the struct (or pointer to struct)
+// v satisfy the constraints of their `assert` tag. Nested structs are checked
+// too. All violated constraints are reported at once.
+//
+// A tag contains comma-separated constraints:
+//
+//	nonzero         field isn't empty (see [NotEmpty])
+//	len=N           length of the field is N, other operators are !=, <, <=, > and >=
+//	min=X, max=X    field is greater/less than or equal to X (numbers and strings)
+//	oneof=a b c     field formatted with %v is one of the space-separated values
+//	regexp=RE       field matches RE, it must be the last constraint of the tag
+//
+// For example:
+//
+//	type User struct {
+//		Name string `assert:"nonzero,len<=64"`
+//		Age  int    `assert:"min=1,max=100"`
+//		Role string `assert:"oneof=admin user"`
+//	}
+//
+//	assert.Struct(user)
+func Struct(v interface{}, msgAndArgs ...interface{}) bool {
+	visited := make(map[visitKey]bool)
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		visited[visitKey{rv.Pointer(), rv.Type()}] = true
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return Fail(fmt.Sprintf("Expected a struct, got %T", v), msgAndArgs...)
+	}
+
+	var violations []string
+	if err := checkStructTags(rv, "", visited, &violations); err != nil {
+		return Fail(err.Error(), msgAndArgs...)
+	}
+	if len(violations) > 0 {
+		return Fail("Struct fields don't satisfy their constraints:\n"+strings.Join(violations, "\n"), msgAndArgs...)
+	}
+
+	return true
+}
+
+// Structf asserts that the exported fields of the struct (or pointer to
+// struct) v satisfy the constraints of their `assert` tag. See [Struct].
+//
+//	assert.Structf(user, "error message %s", "formatted")
+func Structf(v interface{}, msg string, args ...interface{}) bool {
+	return Struct(v, append([]interface{}{msg}, args...)...)
+}
+
+// tagConstraint is a parsed constraint of an `assert` struct tag.
+type tagConstraint struct {
+	// text is the constraint as written in the tag.
+	text  string
+	check func(v reflect.Value) bool
+}
+
+// fieldConstraints contains the constraints of a struct field.
+type fieldConstraints struct {
+	index       int
+	name        string
+	constraints []tagConstraint
+	// nested is true if field is a struct or a pointer to struct.
+	nested bool
+}
+
+// structConstraints contains the parsed `assert` tags of a struct type.
+type structConstraints struct {
+	fields []fieldConstraints
+	err    error
+}
+
+// structConstraintsCache maps struct types to their *structConstraints.
+var structConstraintsCache sync.Map
+
+// checkStructTags appends to violations the constraints of v fields that are
+// not satisfied. Field names are prefixed by prefix. visited contains already
+// checked pointers so cyclic values are supported.
+func checkStructTags(v reflect.Value, prefix string, visited map[visitKey]bool, violations *[]string) error {
+	sc := constraintsOf(v.Type())
+	if sc.err != nil {
+		return sc.err
+	}
+
+	for _, field := range sc.fields {
+		fv := v.Field(field.index)
+		name := prefix + field.name
+
+		for _, c := range field.constraints {
+			if !c.check(fv) {
+				*violations = append(*violations, fmt.Sprintf("%s: %#v doesn't satisfy %q", name, fv.Interface(), c.text))
+			}
+		}
+
+		if field.nested {
+			if fv.Kind() == reflect.Pointer {
+				key := visitKey{fv.Pointer(), fv.Type()}
+				if fv.IsNil() || visited[key] {
+					continue
+				}
+				visited[key] = true
+				fv = fv.Elem()
+			}
+			if err := checkStructTags(fv, name+".", visited, violations); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// constraintsOf returns the cached constraints of struct type t.
+func constraintsOf(t reflect.Type) *structConstraints {
+	if sc, ok := structConstraintsCache.Load(t); ok {
+		return sc.(*structConstraints)
+	}
+
+	sc := &structConstraints{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag, hasTag := field.Tag.Lookup("assert")
+		if !field.IsExported() {
+			if hasTag {
+				sc.err = fmt.Errorf("Invalid assert tag on unexported field %v.%v", t, field.Name)
+				break
+			}
+			continue
+		}
+
+		fc := fieldConstraints{index: i, name: field.Name}
+		if hasTag {
+			constraints, err := parseStructTag(tag, field.Type)
+			if err != nil {
+				sc.err = fmt.Errorf("Invalid assert tag on field %v.%v: %w", t, field.Name, err)
+				break
+			}
+			fc.constraints = constraints
+		}
+
+		ft := field.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		fc.nested = ft.Kind() == reflect.Struct
+		if fc.nested || len(fc.constraints) > 0 {
+			sc.fields = append(sc.fields, fc)
+		}
+	}
+
+	actual, _ := structConstraintsCache.LoadOrStore(t, sc)
+	return actual.(*structConstraints)
+}
+
+// parseStructTag parses the constraints of an `assert` tag of a field of the
+// given type.
+func parseStructTag(tag string, typ reflect.Type) ([]tagConstraint, error) {
+	var constraints []tagConstraint
+	for tag != "" {
+		var text string
+		if strings.HasPrefix(tag, "regexp=") {
+			text, tag = tag, ""
+		} else {
+			text, tag, _ = strings.Cut(tag, ",")
+		}
+
+		check, err := parseConstraint(strings.TrimSpace(text), typ)
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, tagConstraint{text: text, check: check})
+	}
+
+	return constraints, nil
+}
+
+// lenOperators contains len constraint operators. Operators that are prefix
+// of others must come last.
+var lenOperators = []struct {
+	op      string
+	allowed []compareResult
+}{
+	{"!=", []compareResult{compareLess, compareGreater}},
+	{"<=", []compareResult{compareLess, compareEqual}},
+	{">=", []compareResult{compareGreater, compareEqual}},
+	{"<", []compareResult{compareLess}},
+	{">", []compareResult{compareGreater}},
+	{"=", []compareResult{compareEqual}},
+}
+
+func parseConstraint(text string, typ reflect.Type) (func(v reflect.Value) bool, error) {
+	name, arg, hasArg := strings.Cut(text, "=")
+
+	switch {
+	case text == "nonzero":
+		return func(v reflect.Value) bool {
+			return !isEmpty(v.Interface())
+		}, nil
+
+	case strings.HasPrefix(text, "len"):
+		for _, op := range lenOperators {
+			arg, ok := strings.CutPrefix(text, "len"+op.op)
+			if !ok {
+				continue
+			}
+			n, err := strconv.Atoi(arg)
+			if err != nil {
+				return nil, fmt.Errorf("%q: %w", text, err)
+			}
+			return func(v reflect.Value) bool {
+				l, ok := getLen(v.Interface())
+				if !ok {
+					return false
+				}
+				res, _ := compare(l, n, reflect.Int)
+				return containsValue(op.allowed, res)
+			}, nil
+		}
+
+	case hasArg && (name == "min" || name == "max"):
+		bound, err := parseTagValue(arg, typ)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", text, err)
+		}
+		disallowed := compareLess
+		if name == "max" {
+			disallowed = compareGreater
+		}
+		return func(v reflect.Value) bool {
+			res, ok := compare(v.Interface(), bound, typ.Kind())
+			return ok && res != disallowed
+		}, nil
+
+	case hasArg && name == "oneof":
+		values := strings.Fields(arg)
+		return func(v reflect.Value) bool {
+			s := fmt.Sprint(v.Interface())
+			for _, value := range values {
+				if s == value {
+					return true
+				}
+			}
+			return false
+		}, nil
+
+	case hasArg && name == "regexp":
+		rx, err := regexp.Compile(arg)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", text, err)
+		}
+		return func(v reflect.Value) bool {
+			return matchRegexp(rx, v.Interface())
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unknown constraint %q", text)
+}
+
+// parseTagValue parses s as a value of the given type.
+func parseTagValue(s string, typ reflect.Type) (interface{}, error) {
+	v := reflect.New(typ).Elem()
+
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, typ.Bits())
+		if err != nil {
+			return nil, err
+		}
+		v.SetInt(i)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(s, 10, typ.Bits())
+		if err != nil {
+			return nil, err
+		}
+		v.SetUint(u)
+
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, typ.Bits())
+		if err != nil {
+			return nil, err
+		}
+		v.SetFloat(f)
+
+	case reflect.String:
+		v.SetString(s)
+
+	default:
+		return nil, fmt.Errorf("unsupported type %v", typ)
+	}
+
+	return v.Interface(), nil
+}
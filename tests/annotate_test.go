@@ -0,0 +1,74 @@
+//go:build assert
+
+package assert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+func TestWith(t *testing.T) {
+	t.Run("Fields", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			assert.Equal(1, 2, assert.With("tenant", "acme", "shard", 3), "error message %s", "formatted")
+		})
+
+		want := []assert.Field{{Key: "tenant", Value: "acme"}, {Key: "shard", Value: 3}}
+		if len(aerr.Fields) != 2 || aerr.Fields[0] != want[0] || aerr.Fields[1] != want[1] {
+			t.Errorf("unexpected fields: %v", aerr.Fields)
+		}
+		if aerr.UserMessage != "error message formatted" {
+			t.Errorf("unexpected user message: %q", aerr.UserMessage)
+		}
+		if !strings.Contains(aerr.Error(), "\n\ttenant:     \tacme\n\tshard:      \t3\n\tMessages:   \terror message formatted\n") {
+			t.Errorf("unexpected report: %q", aerr.Error())
+		}
+	})
+
+	t.Run("OnlyFields", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			assert.True(false, assert.With("odd"))
+		})
+
+		if len(aerr.Fields) != 1 || aerr.Fields[0] != (assert.Field{Key: "!BADKEY", Value: "odd"}) {
+			t.Errorf("unexpected fields: %v", aerr.Fields)
+		}
+		if aerr.UserMessage != "" {
+			t.Errorf("unexpected user message: %q", aerr.UserMessage)
+		}
+	})
+}
+
+func TestAnnotate(t *testing.T) {
+	done := assert.Annotate("request", 1)
+
+	aerr := recoverAssertionError(t, func() {
+		defer assert.Annotate("step", "parse")()
+		assert.True(false, assert.With("line", 3))
+	})
+	want := []assert.Field{{Key: "line", Value: 3}, {Key: "request", Value: 1}, {Key: "step", Value: "parse"}}
+	if len(aerr.Fields) != 3 || aerr.Fields[0] != want[0] || aerr.Fields[1] != want[1] || aerr.Fields[2] != want[2] {
+		t.Errorf("unexpected fields: %v", aerr.Fields)
+	}
+
+	// Annotations are scoped to the goroutine.
+	r := inGoroutine(func() { assert.True(false) })
+	if aerr, ok := r.(*assert.AssertionError); !ok || len(aerr.Fields) != 0 {
+		t.Errorf("unexpected panic value: %v", r)
+	}
+
+	// Nested annotation was removed.
+	aerr = recoverAssertionError(t, func() { assert.True(false) })
+	if len(aerr.Fields) != 1 || aerr.Fields[0] != want[1] {
+		t.Errorf("unexpected fields: %v", aerr.Fields)
+	}
+
+	done()
+	done()
+	aerr = recoverAssertionError(t, func() { assert.True(false) })
+	if len(aerr.Fields) != 0 {
+		t.Errorf("unexpected fields: %v", aerr.Fields)
+	}
+}
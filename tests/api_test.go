@@ -0,0 +1,121 @@
+package assert
+
+import (
+	"bytes"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// apiSurface returns the exported declarations of the assert package when
+// built with the given build tags. Keys are declaration names and values are
+// their signatures.
+func apiSurface(t *testing.T, tags ...string) map[string]string {
+	ctx := build.Default
+	ctx.BuildTags = tags
+
+	files, err := filepath.Glob("../*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	print := func(node any) string {
+		var buf bytes.Buffer
+		if err := printer.Fprint(&buf, fset, node); err != nil {
+			t.Fatal(err)
+		}
+		return buf.String()
+	}
+
+	api := make(map[string]string)
+	for _, fpath := range files {
+		dir, name := filepath.Split(fpath)
+		if match, err := ctx.MatchFile(dir, name); err != nil {
+			t.Fatal(err)
+		} else if !match {
+			continue
+		}
+
+		src, err := os.ReadFile(fpath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := parser.ParseFile(fset, fpath, src, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if !decl.Name.IsExported() {
+					continue
+				}
+				name := decl.Name.Name
+				if decl.Recv != nil {
+					recv := print(decl.Recv.List[0].Type)
+					if !ast.IsExported(strings.TrimLeft(recv, "*")) {
+						continue
+					}
+					name = recv + "." + name
+				}
+				api[name] = print(decl.Type)
+
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						if spec.Name.IsExported() {
+							api[spec.Name.Name] = print(spec)
+						}
+					case *ast.ValueSpec:
+						for _, ident := range spec.Names {
+							if ident.IsExported() {
+								api[ident.Name] = decl.Tok.String()
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return api
+}
+
+func TestAPISurfaceMatchesBetweenBuilds(t *testing.T) {
+	assertAPI := apiSurface(t, "assert")
+	prodAPI := apiSurface(t)
+
+	var names []string
+	for name := range assertAPI {
+		names = append(names, name)
+	}
+	for name := range prodAPI {
+		if _, ok := assertAPI[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		a, aok := assertAPI[name]
+		p, pok := prodAPI[name]
+		switch {
+		case !pok:
+			t.Errorf("%s is missing in !assert build", name)
+		case !aok:
+			t.Errorf("%s is missing in assert build", name)
+		case a != p:
+			t.Errorf("%s differs between builds:\n\tassert:  %s\n\t!assert: %s", name, a, p)
+		}
+	}
+}
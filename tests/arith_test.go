@@ -0,0 +1,75 @@
+//go:build assert
+
+package assert
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+func TestCheckedArithmetic(t *testing.T) {
+	t.Run("NoOverflow", func(t *testing.T) {
+		if assert.Add(int8(100), 27) != 127 ||
+			assert.Add(int8(-100), -28) != -128 ||
+			assert.Sub(uint(3), 3) != 0 ||
+			assert.Sub(int16(-32767), 1) != -32768 ||
+			assert.Mul(int32(-2), 1<<30) != math.MinInt32 ||
+			assert.Mul(uint8(15), 17) != 255 ||
+			assert.Mul(int64(math.MinInt64), 1) != math.MinInt64 {
+			t.Fatal("unexpected result")
+		}
+	})
+
+	for name, cb := range map[string]func(){
+		"AddSigned":     func() { assert.Add(int8(100), 28) },
+		"AddNegative":   func() { assert.Add(int8(-100), -29) },
+		"AddUnsigned":   func() { assert.Add(uint8(200), 56) },
+		"SubSigned":     func() { assert.Sub(int8(-100), 29) },
+		"SubNegative":   func() { assert.Sub(int8(100), -28) },
+		"SubUnsigned":   func() { assert.Sub(uint(2), 3) },
+		"MulSigned":     func() { assert.Mul(int32(1<<16), 1<<15) },
+		"MulMinusOne":   func() { assert.Mul(int64(-1), math.MinInt64) },
+		"MulMinByMinus": func() { assert.Mul(int8(math.MinInt8), -1) },
+		"MulUnsigned":   func() { assert.Mul(uint8(16), 16) },
+	} {
+		t.Run(name+"Overflow", func(t *testing.T) {
+			aerr := recoverAssertionError(t, cb)
+			if !strings.HasPrefix(aerr.Message, "Integer overflow: ") {
+				t.Errorf("unexpected message: %q", aerr.Message)
+			}
+		})
+	}
+
+	t.Run("AddOverflowMessage", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			assert.Add(uint8(200), 100)
+		})
+		if aerr.Message != "Integer overflow: 200 + 100 overflows uint8" {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+	})
+}
+
+func TestCheckedConvert(t *testing.T) {
+	t.Run("Lossless", func(t *testing.T) {
+		if assert.Convert[int8](int64(-128)) != -128 ||
+			assert.Convert[uint64](int8(127)) != 127 ||
+			assert.Convert[int](uint32(math.MaxUint32)) != math.MaxUint32 {
+			t.Fatal("unexpected result")
+		}
+	})
+
+	for name, cb := range map[string]func(){
+		"Truncation":      func() { assert.Convert[int8](300) },
+		"NegativeToUint":  func() { assert.Convert[uint](-1) },
+		"MaxUintToSigned": func() { assert.Convert[int64](uint64(math.MaxUint64)) },
+		"SignFlip":        func() { assert.Convert[int8](uint8(200)) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			requirePanics(t, cb)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+//go:build assert
+
+package assert
+
+import (
+	"errors"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+// errSentinel is used instead of io.EOF, which is overwritten by ErrorAs tests.
+var errSentinel = errors.New("sentinel")
+
+func recoverAssertionError(t *testing.T, cb func()) (aerr *assert.AssertionError) {
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.As(err, &aerr) {
+			t.Fatalf("expected *assert.AssertionError panic, got %v", err)
+		}
+	}()
+
+	cb()
+	return nil
+}
+
+func TestAssertionError(t *testing.T) {
+	t.Run("Equal", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			assert.Equal("foo", "bar", "user %s", "message")
+		})
+
+		if aerr.Assertion != "Equal" {
+			t.Errorf("unexpected assertion name: %q", aerr.Assertion)
+		}
+		if aerr.Expected != "foo" || aerr.Actual != "bar" {
+			t.Errorf("unexpected expected/actual values: %v %v", aerr.Expected, aerr.Actual)
+		}
+		if !strings.Contains(aerr.Diff, "-foo") || !strings.Contains(aerr.Diff, "+bar") {
+			t.Errorf("unexpected diff: %q", aerr.Diff)
+		}
+		if aerr.UserMessage != "user message" {
+			t.Errorf("unexpected user message: %q", aerr.UserMessage)
+		}
+		if !strings.Contains(strings.Join(aerr.Trace, "\n"), "assertion_error_test.go") {
+			t.Errorf("unexpected trace: %v", aerr.Trace)
+		}
+		if !strings.Contains(aerr.Error(), "Error Trace:") {
+			t.Errorf("unexpected error report: %q", aerr.Error())
+		}
+	})
+
+	t.Run("FormatVariant", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			assert.Truef(false, "msg")
+		})
+
+		if aerr.Assertion != "Truef" {
+			t.Errorf("unexpected assertion name: %q", aerr.Assertion)
+		}
+	})
+
+	t.Run("NoErrorUnwrap", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			assert.NoError(errSentinel)
+		})
+
+		if !errors.Is(aerr, errSentinel) {
+			t.Errorf("expected assertion error to wrap errSentinel")
+		}
+	})
+
+	t.Run("ValueFields", func(t *testing.T) {
+		for name, cb := range map[string]func(){
+			"NotEqual":       func() { assert.NotEqual(1, 1) },
+			"Less":           func() { assert.Less(2, 1) },
+			"GreaterOrEqual": func() { assert.GreaterOrEqual(1, 2) },
+		} {
+			aerr := recoverAssertionError(t, cb)
+			if aerr.Assertion != name || aerr.Expected == nil || aerr.Actual == nil {
+				t.Errorf("%v: unexpected assertion or values: %q %v %v", name, aerr.Assertion, aerr.Expected, aerr.Actual)
+			}
+		}
+
+		aerr := recoverAssertionError(t, func() { assert.Less(2, 1) })
+		if aerr.Expected != 1 || aerr.Actual != 2 {
+			t.Errorf("unexpected expected/actual values: %v %v", aerr.Expected, aerr.Actual)
+		}
+	})
+
+	t.Run("ErrorFields", func(t *testing.T) {
+		var pathErr *fs.PathError
+		for name, cb := range map[string]func(){
+			"EqualError":    func() { assert.EqualError(errSentinel, "boom") },
+			"ErrorContains": func() { assert.ErrorContains(errSentinel, "boom") },
+			"ErrorAs":       func() { assert.ErrorAs(errSentinel, &pathErr) },
+		} {
+			aerr := recoverAssertionError(t, cb)
+			if aerr.Assertion != name || !errors.Is(aerr, errSentinel) {
+				t.Errorf("%v: unexpected assertion or error: %q %v", name, aerr.Assertion, aerr.Err)
+			}
+		}
+
+		aerr := recoverAssertionError(t, func() { assert.EqualError(errSentinel, "boom") })
+		if aerr.Expected != "boom" || aerr.Actual != "sentinel" {
+			t.Errorf("unexpected expected/actual values: %v %v", aerr.Expected, aerr.Actual)
+		}
+	})
+}
@@ -49,6 +49,17 @@ func BenchmarkSliceIndexWithBoundCheckAssertions(b *testing.B) {
 	}
 }
 
+func BenchmarkSliceIndexWithCheckedIndex(b *testing.B) {
+	get := func(slice []string, index int) string {
+		return assert.Index(slice, index)
+	}
+	days := []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+
+	for i := 0; i < b.N; i++ {
+		_ = get(days, i%len(days))
+	}
+}
+
 func TestAssertCondition(t *testing.T) {
 	t.Run("ReturnsTrueOk", func(t *testing.T) {
 		assert.Condition(func() bool {
@@ -824,6 +835,19 @@ func TestAssertLocked(t *testing.T) {
 		requirePanics(t, func() {
 			assert.Locked(&mu)
 		})
+		if !mu.TryLock() {
+			t.Fatal("mutex left locked")
+		}
+	})
+
+	t.Run("UnlockedWithReturningHandler", func(t *testing.T) {
+		defer assert.SetFailureHandler(assert.SetFailureHandler(func(error) {}))
+
+		var mu sync.Mutex
+		assert.Locked(&mu)
+		if !mu.TryLock() {
+			t.Fatal("mutex left locked")
+		}
 	})
 }
 
@@ -840,4 +864,101 @@ func TestAssertUnlocked(t *testing.T) {
 			assert.Unlocked(&mu)
 		})
 	})
+
+	t.Run("LockedWithReturningHandler", func(t *testing.T) {
+		defer assert.SetFailureHandler(assert.SetFailureHandler(func(error) {}))
+
+		var mu sync.Mutex
+		mu.Lock()
+		assert.Unlocked(&mu)
+		if mu.TryLock() {
+			t.Fatal("mutex of another owner was unlocked")
+		}
+	})
+}
+
+func TestAssertRLocked(t *testing.T) {
+	t.Run("RLocked", func(t *testing.T) {
+		var rw sync.RWMutex
+		rw.RLock()
+		if !assert.RLocked(&rw) {
+			t.Error("RLocked returned false")
+		}
+	})
+
+	t.Run("Unlocked", func(t *testing.T) {
+		var rw sync.RWMutex
+		requirePanics(t, func() {
+			assert.RLocked(&rw)
+		})
+	})
+
+	t.Run("WriteLocked", func(t *testing.T) {
+		var rw assert.RWMutex
+		rw.Lock()
+		requirePanics(t, func() {
+			assert.RLocked(&rw)
+		})
+	})
+}
+
+func TestAssertRUnlocked(t *testing.T) {
+	t.Run("Unlocked", func(t *testing.T) {
+		var rw sync.RWMutex
+		assert.RUnlocked(&rw)
+	})
+
+	t.Run("WriteLocked", func(t *testing.T) {
+		var rw assert.RWMutex
+		rw.Lock()
+		assert.RUnlocked(&rw)
+	})
+
+	t.Run("RLocked", func(t *testing.T) {
+		var rw sync.RWMutex
+		rw.RLock()
+		requirePanics(t, func() {
+			assert.RUnlocked(&rw)
+		})
+	})
+
+	t.Run("RLockedWithPendingWriter", func(t *testing.T) {
+		var rw assert.RWMutex
+		rw.RLock()
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			rw.Lock()
+			rw.Unlock()
+		}()
+		for rw.TryRLock() {
+			rw.RUnlock()
+		}
+		requirePanics(t, func() {
+			assert.RUnlocked(&rw)
+		})
+		rw.RUnlock()
+		<-done
+	})
+}
+
+func TestAssertLockedAny(t *testing.T) {
+	t.Run("RLocked", func(t *testing.T) {
+		var rw sync.RWMutex
+		rw.RLock()
+		assert.LockedAny(&rw)
+	})
+
+	t.Run("Locked", func(t *testing.T) {
+		var rw sync.RWMutex
+		rw.Lock()
+		assert.LockedAny(&rw)
+	})
+
+	t.Run("Unlocked", func(t *testing.T) {
+		var rw sync.RWMutex
+		requirePanics(t, func() {
+			assert.LockedAny(&rw)
+		})
+	})
 }
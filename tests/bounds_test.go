@@ -0,0 +1,71 @@
+//go:build assert
+
+package assert
+
+import (
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+func TestAssertIndex(t *testing.T) {
+	days := make([]string, 3, 8)
+	days[2] = "Wednesday"
+
+	t.Run("InBounds", func(t *testing.T) {
+		if assert.Index(days, 2) != "Wednesday" {
+			t.Fatal("unexpected element")
+		}
+	})
+
+	t.Run("OutOfBounds", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			assert.Index(days, 3)
+		})
+		if aerr.Message != "Index out of range: index 3, len 3, cap 8" {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+	})
+
+	t.Run("Negative", func(t *testing.T) {
+		requirePanics(t, func() {
+			assert.Index(days, -1)
+		})
+	})
+}
+
+func TestAssertSlice(t *testing.T) {
+	days := make([]string, 3, 8)
+
+	t.Run("InBounds", func(t *testing.T) {
+		if len(assert.Slice(days, 1, 8)) != 7 {
+			t.Fatal("unexpected slice")
+		}
+	})
+
+	t.Run("OutOfBounds", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			assert.Slice(days, 2, 9)
+		})
+		if aerr.Message != "Slice bounds out of range: [2:9], len 3, cap 8" {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+	})
+
+	t.Run("Inverted", func(t *testing.T) {
+		requirePanics(t, func() {
+			assert.Slice(days, 2, 1)
+		})
+	})
+}
+
+func TestAssertInBounds(t *testing.T) {
+	assert.InBounds(0, 1)
+
+	requirePanics(t, func() {
+		assert.InBounds(1, 1)
+	})
+	requirePanics(t, func() {
+		assert.InBoundsf(-1, 1, "msg")
+	})
+}
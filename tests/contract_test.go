@@ -0,0 +1,140 @@
+//go:build assert
+
+package assert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+type contractQueue struct {
+	items []int
+}
+
+func (q *contractQueue) push(v int, buggy bool) {
+	oldItems := assert.Old(q.items)
+	defer assert.Ensures(func() bool {
+		return len(q.items) == len(oldItems)+1 && q.items[len(q.items)-1] == v
+	}, "item must be appended")
+
+	if len(q.items) > 0 {
+		q.items[0]++ // Doesn't affect oldItems.
+	}
+	if !buggy {
+		q.items = append(q.items, v)
+	}
+}
+
+func TestAssertRequires(t *testing.T) {
+	t.Run("True", func(t *testing.T) {
+		assert.Requires(true)
+	})
+
+	t.Run("False", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			assert.Requires(false, "n must be positive")
+		})
+
+		if aerr.Kind != "Precondition" {
+			t.Errorf("unexpected kind: %q", aerr.Kind)
+		}
+		if !strings.Contains(aerr.Error(), "Precondition:") || strings.Contains(aerr.Error(), "Error:") {
+			t.Errorf("unexpected error report: %q", aerr.Error())
+		}
+	})
+}
+
+func TestAssertEnsures(t *testing.T) {
+	t.Run("Satisfied", func(t *testing.T) {
+		q := contractQueue{items: []int{1}}
+		q.push(2, false)
+	})
+
+	t.Run("Violated", func(t *testing.T) {
+		q := contractQueue{items: []int{1}}
+		aerr := recoverAssertionError(t, func() {
+			q.push(2, true)
+		})
+
+		if aerr.Assertion != "Ensures" || aerr.Kind != "Postcondition" {
+			t.Errorf("unexpected assertion name or kind: %q %q", aerr.Assertion, aerr.Kind)
+		}
+		if aerr.UserMessage != "item must be appended" {
+			t.Errorf("unexpected user message: %q", aerr.UserMessage)
+		}
+	})
+}
+
+func TestAssertOld(t *testing.T) {
+	type node struct {
+		Values map[string][]int
+		Next   *node
+	}
+
+	n := &node{Values: map[string][]int{"a": {1, 2}}}
+	n.Next = n
+
+	old := assert.Old(n)
+	n.Values["a"][0] = 42
+	n.Values["b"] = nil
+
+	if old == n || old.Next != old {
+		t.Fatal("pointers weren't copied")
+	}
+	if len(old.Values) != 1 || old.Values["a"][0] != 1 {
+		t.Fatalf("unexpected snapshot: %v", old.Values)
+	}
+}
+
+func TestAssertOldNilInterface(t *testing.T) {
+	if old := assert.Old[error](nil); old != nil {
+		t.Fatalf("unexpected snapshot: %v", old)
+	}
+
+	var err error = errSentinel
+	if old := assert.Old(err); old == nil || old.Error() != errSentinel.Error() {
+		t.Fatalf("unexpected snapshot: %v", old)
+	}
+}
+
+func TestAssertOldSharedAddresses(t *testing.T) {
+	t.Run("EmptySlices", func(t *testing.T) {
+		v := struct {
+			A []int
+			B []string
+		}{make([]int, 0), make([]string, 0)}
+
+		old := assert.Old(v)
+		if old.A == nil || len(old.A) != 0 || old.B == nil || len(old.B) != 0 {
+			t.Fatalf("unexpected snapshot: %#v", old)
+		}
+	})
+
+	t.Run("FieldPointer", func(t *testing.T) {
+		type S struct{ A int }
+		s := &S{A: 1}
+		v := struct {
+			P *S
+			Q *int
+		}{s, &s.A}
+
+		old := assert.Old(v)
+		s.A = 2
+		if old.P.A != 1 || *old.Q != 1 {
+			t.Fatalf("unexpected snapshot: %v %v", *old.P, *old.Q)
+		}
+	})
+
+	t.Run("PointerKeys", func(t *testing.T) {
+		k := new(int)
+		m := map[*int]string{k: "a"}
+
+		old := assert.Old(m)
+		m[k] = "b"
+		if old[k] != "a" {
+			t.Fatalf("unexpected snapshot: %v", old)
+		}
+	})
+}
@@ -0,0 +1,39 @@
+//go:build assert
+
+package assert
+
+import (
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+func TestDebug(t *testing.T) {
+	called := false
+	assert.Debug(func() {
+		called = true
+	})
+	if !called {
+		t.Fatal("debug function wasn't called")
+	}
+}
+
+func TestShadow(t *testing.T) {
+	var s struct {
+		live assert.Shadow[map[int]bool]
+		gen  assert.Shadow[int]
+		n    int
+	}
+
+	if s.gen.Get() != 0 {
+		t.Fatal("zero value doesn't hold zero value")
+	}
+
+	s.live.Set(map[int]bool{1: true})
+	s.gen.Update(func(gen int) int { return gen + 1 })
+	s.gen.Update(func(gen int) int { return gen + 1 })
+
+	if !s.live.Get()[1] || s.gen.Get() != 2 {
+		t.Fatalf("unexpected shadow values: %v %v", s.live.Get(), s.gen.Get())
+	}
+}
@@ -0,0 +1,49 @@
+//go:build assert
+
+package assert
+
+import (
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+func TestSetFailureHandler(t *testing.T) {
+	t.Run("CustomHandlerIsCalled", func(t *testing.T) {
+		var failures []error
+		prev := assert.SetFailureHandler(func(err error) {
+			failures = append(failures, err)
+		})
+		defer assert.SetFailureHandler(prev)
+
+		if assert.True(false) {
+			t.Fatal("failed assertion returned true")
+		}
+		if !assert.True(true) {
+			t.Fatal("successful assertion returned false")
+		}
+
+		if len(failures) != 1 {
+			t.Fatalf("expected 1 failure, got %d", len(failures))
+		}
+	})
+
+	t.Run("NilRestoresPanicHandler", func(t *testing.T) {
+		prev := assert.SetFailureHandler(assert.LogHandler)
+		defer assert.SetFailureHandler(prev)
+
+		assert.SetFailureHandler(nil)
+		requirePanics(t, func() {
+			assert.True(false)
+		})
+	})
+
+	t.Run("LogHandlerContinues", func(t *testing.T) {
+		prev := assert.SetFailureHandler(assert.LogHandler)
+		defer assert.SetFailureHandler(prev)
+
+		if assert.Equal(1, 2) {
+			t.Fatal("failed assertion returned true")
+		}
+	})
+}
@@ -0,0 +1,26 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+func TestReportHelpers(t *testing.T) {
+	if diff := assert.Diff("a\nb\n", "a\nc\n"); !strings.HasPrefix(diff, "--- Expected\n+++ Actual\n") {
+		t.Errorf("unexpected diff: %q", diff)
+	}
+	if diff := assert.Diff(1, 2); diff != "" {
+		t.Errorf("unexpected diff: %q", diff)
+	}
+
+	if e, a := assert.FormatValues(int32(1), int64(1)); e != "int32(1)" || a != "int64(1)" {
+		t.Errorf("unexpected formatted values: %q %q", e, a)
+	}
+
+	out := assert.LabeledOutput(assert.Field{Key: "A", Value: 1}, assert.Field{Key: "Long", Value: "x\ny"})
+	if out != "\tA:   \t1\n\tLong:\tx\n\t     \ty\n" {
+		t.Errorf("unexpected output: %q", out)
+	}
+}
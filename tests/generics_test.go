@@ -0,0 +1,100 @@
+//go:build assert
+
+package assert
+
+import (
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+func TestAssertEqualT(t *testing.T) {
+	t.Run("EqualOk", func(t *testing.T) {
+		assert.EqualT(1, 1)
+		assert.EqualT("foo", "foo")
+		assert.NotEqualT(1, 2)
+	})
+
+	t.Run("NotEqualPanics", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			assert.EqualT(int64(1), 2)
+		})
+
+		if aerr.Assertion != "EqualT" {
+			t.Errorf("unexpected assertion name: %q", aerr.Assertion)
+		}
+		if aerr.Expected != int64(1) || aerr.Actual != int64(2) {
+			t.Errorf("unexpected expected/actual values: %v %v", aerr.Expected, aerr.Actual)
+		}
+	})
+
+	t.Run("NotEqualEqualPanics", func(t *testing.T) {
+		requirePanics(t, func() {
+			assert.NotEqualTf("foo", "foo", "msg")
+		})
+	})
+}
+
+func TestAssertOrderedT(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		assert.LessT(1, 2)
+		assert.LessOrEqualT(2, 2)
+		assert.GreaterT("b", "a")
+		assert.GreaterOrEqualT(2.5, 2.5)
+	})
+
+	for name, cb := range map[string]func(){
+		"LessT":           func() { assert.LessT(2, 2) },
+		"LessOrEqualT":    func() { assert.LessOrEqualT(3, 2) },
+		"GreaterT":        func() { assert.GreaterT("a", "a") },
+		"GreaterOrEqualT": func() { assert.GreaterOrEqualT(1, 2) },
+	} {
+		t.Run(name+"Panics", func(t *testing.T) {
+			requirePanics(t, cb)
+		})
+	}
+}
+
+func TestAssertContainsT(t *testing.T) {
+	type days []string
+	week := days{"Monday", "Tuesday"}
+
+	t.Run("Ok", func(t *testing.T) {
+		assert.ContainsT(week, "Monday")
+		assert.NotContainsT(week, "Sunday")
+	})
+
+	t.Run("ContainsPanics", func(t *testing.T) {
+		requirePanics(t, func() {
+			assert.ContainsT(week, "Sunday")
+		})
+	})
+
+	t.Run("NotContainsPanics", func(t *testing.T) {
+		requirePanics(t, func() {
+			assert.NotContainsT(week, "Monday")
+		})
+	})
+}
+
+func TestAssertNilPtr(t *testing.T) {
+	var nilPtr *int
+	ptr := new(int)
+
+	t.Run("Ok", func(t *testing.T) {
+		assert.NilPtr(nilPtr)
+		assert.NotNilPtr(ptr)
+	})
+
+	t.Run("NilPtrPanics", func(t *testing.T) {
+		requirePanics(t, func() {
+			assert.NilPtr(ptr)
+		})
+	})
+
+	t.Run("NotNilPtrPanics", func(t *testing.T) {
+		requirePanics(t, func() {
+			assert.NotNilPtr(nilPtr)
+		})
+	})
+}
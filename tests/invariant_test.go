@@ -0,0 +1,131 @@
+//go:build assert
+
+package assert
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+type invariantUser struct {
+	Name string
+}
+
+func (u *invariantUser) CheckInvariant() error {
+	if u.Name == "" {
+		return errors.New("name must not be empty")
+	}
+	return nil
+}
+
+type invariantItem struct {
+	Owner invariantUser
+	Tags  map[string]*invariantUser
+}
+
+type invariantOrder struct {
+	Items []invariantItem
+	Next  *invariantOrder
+	calls *int
+}
+
+func (o invariantOrder) CheckInvariant() error {
+	*o.calls++
+	if len(o.Items) == 0 {
+		return errors.New("order must have items")
+	}
+	return nil
+}
+
+func TestAssertValid(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		calls := 0
+		order := &invariantOrder{
+			Items: []invariantItem{{Owner: invariantUser{Name: "alice"}}},
+			calls: &calls,
+		}
+		order.Next = order
+
+		assert.Valid(order)
+		if calls != 1 {
+			t.Fatalf("expected CheckInvariant to be called once, got %d", calls)
+		}
+	})
+
+	t.Run("RootViolation", func(t *testing.T) {
+		calls := 0
+		aerr := recoverAssertionError(t, func() {
+			assert.Valid(invariantOrder{calls: &calls})
+		})
+
+		if aerr.Message != "root: order must have items" {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+	})
+
+	t.Run("PointerReceiverOnValue", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			assert.Valid(invariantUser{})
+		})
+		if aerr.Message != "root: name must not be empty" {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+
+		aerr = recoverAssertionError(t, func() {
+			assert.Valid(invariantItem{})
+		})
+		if aerr.Message != "root.Owner: name must not be empty" {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+
+		aerr = recoverAssertionError(t, func() {
+			assert.Valid(map[string]interface{}{"a": invariantUser{}})
+		})
+		if aerr.Message != `root["a"]: name must not be empty` {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+	})
+
+	t.Run("PointerToFirstField", func(t *testing.T) {
+		// A pointer to a struct and a pointer to its first field share the
+		// same address.
+		item := &invariantItem{
+			Owner: invariantUser{Name: "alice"},
+			Tags:  map[string]*invariantUser{"b": {}},
+		}
+		aerr := recoverAssertionError(t, func() {
+			assert.Valid([]interface{}{&item.Owner, item})
+		})
+		if aerr.Message != `root[1].Tags["b"]: name must not be empty` {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+	})
+
+	t.Run("NestedViolation", func(t *testing.T) {
+		calls := 0
+		order := &invariantOrder{
+			Items: []invariantItem{
+				{Owner: invariantUser{Name: "alice"}},
+				{Owner: invariantUser{Name: "bob"}, Tags: map[string]*invariantUser{
+					"a": {Name: "carol"},
+					"b": {},
+				}},
+				{},
+			},
+			calls: &calls,
+		}
+
+		aerr := recoverAssertionError(t, func() {
+			assert.Valid(order)
+		})
+
+		if aerr.Message != `root.Items[1].Tags["b"]: name must not be empty` {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+		if aerr.Kind != "Invariant" || aerr.Err == nil {
+			t.Errorf("unexpected kind or error: %q %v", aerr.Kind, aerr.Err)
+		}
+	})
+}
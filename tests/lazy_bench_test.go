@@ -0,0 +1,46 @@
+package assert
+
+import (
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+//go:noinline
+func buildSlice(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}
+
+func BenchmarkEagerAssertionArguments(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		assert.Len(buildSlice(64), 64)
+	}
+}
+
+func BenchmarkLazyThatAssertion(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		assert.That(func() bool {
+			return len(buildSlice(64)) == 64
+		})
+	}
+}
+
+func BenchmarkLazyAssertionBlock(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		assert.Lazy(func() {
+			assert.Len(buildSlice(64), 64)
+		})
+	}
+}
+
+func BenchmarkLazyAssertionMessage(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		assert.True(i >= 0, func() string {
+			return string(rune(i))
+		})
+	}
+}
@@ -0,0 +1,70 @@
+//go:build assert
+
+package assert
+
+import (
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+func TestAssertThat(t *testing.T) {
+	t.Run("ReturnsTrueOk", func(t *testing.T) {
+		assert.That(func() bool {
+			return true
+		})
+	})
+
+	t.Run("ReturnsFalsePanics", func(t *testing.T) {
+		requirePanics(t, func() {
+			assert.That(func() bool {
+				return false
+			})
+		})
+	})
+}
+
+func TestAssertLazy(t *testing.T) {
+	t.Run("Called", func(t *testing.T) {
+		called := false
+		assert.Lazy(func() {
+			called = true
+		})
+		if !called {
+			t.Fatal("lazy function wasn't called")
+		}
+	})
+
+	t.Run("FailingAssertionPanics", func(t *testing.T) {
+		requirePanics(t, func() {
+			assert.Lazy(func() {
+				assert.Len([]int{1, 2}, 3)
+			})
+		})
+	})
+}
+
+func TestLazyMessage(t *testing.T) {
+	t.Run("NotCalledOnSuccess", func(t *testing.T) {
+		assert.True(true, func() string {
+			t.Fatal("lazy message computed on success")
+			return ""
+		})
+	})
+
+	t.Run("CalledOnFailure", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			assert.True(false, "%s %s", func() string { return "lazy" }, "arg")
+		})
+		if aerr.UserMessage != "lazy arg" {
+			t.Fatalf("unexpected user message: %q", aerr.UserMessage)
+		}
+
+		aerr = recoverAssertionError(t, func() {
+			assert.True(false, func() string { return "lazy message" })
+		})
+		if aerr.UserMessage != "lazy message" {
+			t.Fatalf("unexpected user message: %q", aerr.UserMessage)
+		}
+	})
+}
@@ -0,0 +1,143 @@
+//go:build assert
+
+package assert
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/negrel/assert"
+)
+
+func TestLockBudget(t *testing.T) {
+	t.Run("WithinBudget", func(t *testing.T) {
+		var mu assert.Mutex
+		mu.SetBudget(assert.LockBudget{Hold: time.Minute, Wait: time.Minute})
+		mu.Lock()
+		mu.Unlock()
+	})
+
+	t.Run("HoldExceeded", func(t *testing.T) {
+		var mu assert.Mutex
+		mu.SetBudget(assert.LockBudget{Hold: time.Millisecond})
+		mu.Lock()
+		time.Sleep(2 * time.Millisecond)
+
+		aerr := recoverAssertionError(t, func() {
+			mu.Unlock()
+		})
+		if !strings.Contains(aerr.Message, "longer than its 1ms hold budget") {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+		if !strings.Contains(aerr.Message, "acquired at:") || !strings.Contains(aerr.Message, "lockbudget_test.go") {
+			t.Errorf("expected acquisition stack in message: %q", aerr.Message)
+		}
+
+		// Lock is released even if failure handler panics.
+		if !mu.TryLock() {
+			t.Fatal("mutex wasn't unlocked")
+		}
+		mu.Unlock()
+	})
+
+	t.Run("ReadHoldExceeded", func(t *testing.T) {
+		var rw assert.RWMutex
+		rw.SetBudget(assert.LockBudget{Hold: time.Millisecond})
+		rw.RLock()
+		rw.RLock()
+		rw.RUnlock()
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			rw.RLock()
+			rw.RUnlock()
+		}()
+		<-done
+
+		time.Sleep(2 * time.Millisecond)
+		aerr := recoverAssertionError(t, func() {
+			rw.RUnlock()
+		})
+		if !strings.Contains(aerr.Message, "RWMutex read lock held for") || !strings.Contains(aerr.Message, "lockbudget_test.go") {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+
+		// Read lock is released even if failure handler panics.
+		if !rw.TryLock() {
+			t.Fatal("RWMutex wasn't unlocked")
+		}
+		rw.Unlock()
+	})
+
+	t.Run("WaitExceeded", func(t *testing.T) {
+		var mu assert.Mutex
+		mu.SetBudget(assert.LockBudget{Wait: time.Millisecond})
+
+		locked := make(chan struct{})
+		go func() {
+			mu.Lock()
+			close(locked)
+			time.Sleep(5 * time.Millisecond)
+			mu.Unlock()
+		}()
+		<-locked
+
+		// Violation is reported once the lock is released.
+		mu.Lock()
+		aerr := recoverAssertionError(t, func() {
+			mu.Unlock()
+		})
+		if !strings.Contains(aerr.Message, "acquiring Mutex, longer than its 1ms wait budget") {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+		if !strings.Contains(aerr.Message, "acquired at:") || !strings.Contains(aerr.Message, "lockbudget_test.go") {
+			t.Errorf("expected acquisition stack in message: %q", aerr.Message)
+		}
+
+		if !mu.TryLock() {
+			t.Fatal("mutex wasn't unlocked")
+		}
+		mu.Unlock()
+	})
+
+	t.Run("ReadWaitExceeded", func(t *testing.T) {
+		var rw assert.RWMutex
+		rw.SetBudget(assert.LockBudget{Wait: time.Millisecond})
+
+		locked := make(chan struct{})
+		go func() {
+			rw.Lock()
+			close(locked)
+			time.Sleep(5 * time.Millisecond)
+			rw.Unlock()
+		}()
+		<-locked
+
+		rw.RLock()
+		aerr := recoverAssertionError(t, func() {
+			rw.RUnlock()
+		})
+		if !strings.Contains(aerr.Message, "acquiring RWMutex, longer than its 1ms wait budget") {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+
+		if !rw.TryLock() {
+			t.Fatal("RWMutex wasn't unlocked")
+		}
+		rw.Unlock()
+	})
+
+	t.Run("DefaultBudget", func(t *testing.T) {
+		prev := assert.SetDefaultLockBudget(assert.LockBudget{Hold: time.Millisecond})
+		defer assert.SetDefaultLockBudget(prev)
+
+		var mu assert.Mutex
+		mu.Lock()
+		time.Sleep(2 * time.Millisecond)
+		requirePanics(t, func() {
+			mu.Unlock()
+		})
+	})
+}
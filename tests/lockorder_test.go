@@ -0,0 +1,103 @@
+//go:build assert
+
+package assert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+func TestLockOrderChecks(t *testing.T) {
+	prev := assert.SetLockOrderChecks(true)
+	defer assert.SetLockOrderChecks(prev)
+
+	t.Run("ConsistentOrder", func(t *testing.T) {
+		var a, b assert.Mutex
+		for i := 0; i < 2; i++ {
+			a.Lock()
+			b.Lock()
+			b.Unlock()
+			a.Unlock()
+		}
+	})
+
+	t.Run("Inversion", func(t *testing.T) {
+		var a, b assert.Mutex
+		a.Lock()
+		b.Lock()
+		b.Unlock()
+		a.Unlock()
+
+		b.Lock()
+		defer b.Unlock()
+		aerr := recoverAssertionError(t, func() {
+			a.Lock()
+		})
+
+		if !strings.Contains(aerr.Message, "Lock order inversion") {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+		current, previous, ok := strings.Cut(aerr.Message, "was previously acquired")
+		if !ok || !strings.Contains(current, "lockorder_test.go") || !strings.Contains(previous, "lockorder_test.go") {
+			t.Errorf("expected both acquisitions stacks in message: %q", aerr.Message)
+		}
+	})
+
+	t.Run("ReportedOnce", func(t *testing.T) {
+		var a, b assert.Mutex
+		a.Lock()
+		b.Lock()
+		b.Unlock()
+		a.Unlock()
+
+		b.Lock()
+		requirePanics(t, func() { a.Lock() })
+		a.Lock()
+		a.Unlock()
+		b.Unlock()
+	})
+
+	t.Run("IndirectInversionAcrossGoroutines", func(t *testing.T) {
+		var a, b assert.Mutex
+		var c assert.RWMutex
+
+		if p := inGoroutine(func() {
+			a.Lock()
+			c.RLock()
+			c.RUnlock()
+			a.Unlock()
+		}); p != nil {
+			t.Fatal(p)
+		}
+		if p := inGoroutine(func() {
+			c.Lock()
+			b.Lock()
+			b.Unlock()
+			c.Unlock()
+		}); p != nil {
+			t.Fatal(p)
+		}
+
+		b.Lock()
+		defer b.Unlock()
+		requirePanics(t, func() { a.Lock() })
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		assert.SetLockOrderChecks(false)
+		defer assert.SetLockOrderChecks(true)
+
+		var a, b assert.Mutex
+		a.Lock()
+		b.Lock()
+		b.Unlock()
+		a.Unlock()
+
+		b.Lock()
+		a.Lock()
+		a.Unlock()
+		b.Unlock()
+	})
+}
@@ -0,0 +1,129 @@
+//go:build assert
+
+package assert
+
+import (
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+// inGoroutine calls cb in a new goroutine and returns the value it panicked
+// with, if any.
+func inGoroutine(cb func()) (panicked any) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() { panicked = recover() }()
+		cb()
+	}()
+	<-done
+
+	return panicked
+}
+
+func TestAssertLockedByCaller(t *testing.T) {
+	t.Run("LockedByCaller", func(t *testing.T) {
+		var mu assert.Mutex
+		mu.Lock()
+		defer mu.Unlock()
+
+		assert.LockedByCaller(&mu)
+	})
+
+	t.Run("Unlocked", func(t *testing.T) {
+		var mu assert.Mutex
+		requirePanics(t, func() {
+			assert.LockedByCaller(&mu)
+		})
+	})
+
+	t.Run("LockedByOtherGoroutine", func(t *testing.T) {
+		var mu assert.RWMutex
+		mu.Lock()
+		defer mu.Unlock()
+
+		if inGoroutine(func() { assert.LockedByCaller(&mu) }) == nil {
+			t.Fatal("no panics")
+		}
+	})
+}
+
+func TestAssertNotLockedByCaller(t *testing.T) {
+	t.Run("Unlocked", func(t *testing.T) {
+		var mu assert.Mutex
+		assert.NotLockedByCaller(&mu)
+	})
+
+	t.Run("LockedByOtherGoroutine", func(t *testing.T) {
+		var mu assert.Mutex
+		mu.Lock()
+		defer mu.Unlock()
+
+		if p := inGoroutine(func() { assert.NotLockedByCaller(&mu) }); p != nil {
+			t.Fatal(p)
+		}
+	})
+
+	t.Run("LockedByCaller", func(t *testing.T) {
+		var mu assert.RWMutex
+		mu.Lock()
+		defer mu.Unlock()
+
+		requirePanics(t, func() {
+			assert.NotLockedByCaller(&mu)
+		})
+	})
+}
+
+func TestMutexMisuse(t *testing.T) {
+	t.Run("RecursiveLock", func(t *testing.T) {
+		var mu assert.Mutex
+		mu.Lock()
+		defer mu.Unlock()
+
+		requirePanics(t, func() {
+			mu.Lock()
+		})
+	})
+
+	t.Run("UnlockOfUnlocked", func(t *testing.T) {
+		var mu assert.Mutex
+		requirePanics(t, func() {
+			mu.Unlock()
+		})
+	})
+
+	t.Run("UnlockByOtherGoroutine", func(t *testing.T) {
+		var mu assert.Mutex
+		mu.Lock()
+		defer mu.Unlock()
+
+		if inGoroutine(func() { mu.Unlock() }) == nil {
+			t.Fatal("no panics")
+		}
+	})
+
+	t.Run("ReadLockByWriter", func(t *testing.T) {
+		var mu assert.RWMutex
+		mu.Lock()
+		defer mu.Unlock()
+
+		requirePanics(t, func() {
+			mu.RLock()
+		})
+	})
+
+	t.Run("TryLock", func(t *testing.T) {
+		var mu assert.Mutex
+		if !mu.TryLock() {
+			t.Fatal("failed to lock unlocked mutex")
+		}
+		assert.LockedByCaller(&mu)
+		if mu.TryLock() {
+			t.Fatal("locked mutex twice")
+		}
+		mu.Unlock()
+		assert.NotLockedByCaller(&mu)
+	})
+}
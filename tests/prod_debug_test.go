@@ -0,0 +1,31 @@
+//go:build !assert
+
+package assert
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/negrel/assert"
+)
+
+func TestShadowIsZeroSize(t *testing.T) {
+	type withShadow struct {
+		gen assert.Shadow[[64]int]
+		n   int
+	}
+
+	if size := unsafe.Sizeof(withShadow{}); size != unsafe.Sizeof(int(0)) {
+		t.Fatalf("shadow state changed struct layout: size is %d", size)
+	}
+
+	var s assert.Shadow[int]
+	s.Set(1)
+	s.Update(func(int) int {
+		t.Fatal("update function called")
+		return 0
+	})
+	if s.Get() != 0 {
+		t.Fatal("shadow holds a value")
+	}
+}
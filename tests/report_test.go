@@ -0,0 +1,70 @@
+//go:build assert
+
+package assert
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+type user struct {
+	ID   int
+	Name string
+}
+
+func validUser(u user, msgAndArgs ...interface{}) bool {
+	assert.Helper()
+	if u.Name == "" {
+		return assert.FailReport(assert.Report{
+			Message: "User has no name",
+			Fields:  []assert.Field{{Key: "ID", Value: u.ID}},
+		}, msgAndArgs...)
+	}
+	return true
+}
+
+func TestFailReport(t *testing.T) {
+	t.Run("Helper", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			validUser(user{ID: 42}, "loading user")
+		})
+
+		if aerr.Assertion != "validUser" || aerr.Expression != `validUser(user{ID: 42}, "loading user")` {
+			t.Errorf("unexpected assertion or expression: %q %q", aerr.Assertion, aerr.Expression)
+		}
+		if len(aerr.Fields) != 1 || aerr.Fields[0] != (assert.Field{Key: "ID", Value: 42}) {
+			t.Errorf("unexpected fields: %v", aerr.Fields)
+		}
+		report := aerr.Error()
+		if !strings.Contains(report, "\tError:      \tUser has no name\n\tID:         \t42\n\tMessages:   \tloading user\n") {
+			t.Errorf("unexpected report: %q", report)
+		}
+	})
+
+	t.Run("Values", func(t *testing.T) {
+		err := errors.New("boom")
+		aerr := recoverAssertionError(t, func() {
+			assert.FailReport(assert.Report{
+				Name:     "SameUser",
+				Kind:     "Invariant",
+				Message:  "Users differ",
+				Expected: user{ID: 1, Name: "a"},
+				Actual:   user{ID: 1, Name: "b"},
+				Err:      err,
+			})
+		})
+
+		if aerr.Assertion != "SameUser" || !strings.HasPrefix(aerr.Expression, "assert.FailReport(") {
+			t.Errorf("unexpected assertion or expression: %q %q", aerr.Assertion, aerr.Expression)
+		}
+		if !strings.HasPrefix(aerr.Message, "Users differ: \nexpected: ") || aerr.Diff == "" {
+			t.Errorf("unexpected message or diff: %q %q", aerr.Message, aerr.Diff)
+		}
+		if !errors.Is(aerr, err) || !strings.Contains(aerr.Error(), "\tInvariant:") {
+			t.Errorf("unexpected error: %v", aerr)
+		}
+	})
+}
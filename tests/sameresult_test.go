@@ -0,0 +1,74 @@
+//go:build assert
+
+package assert
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+func TestSameResult(t *testing.T) {
+	t.Run("Same", func(t *testing.T) {
+		result := assert.SameResult(
+			func() []int { return []int{1, 2} },
+			func() []int { return []int{1, 2} },
+		)
+		if len(result) != 2 {
+			t.Fatalf("unexpected result: %v", result)
+		}
+	})
+
+	t.Run("Different", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			assert.SameResult(
+				func() []int { return []int{1, 3} },
+				func() []int { return []int{1, 2} },
+			)
+		})
+
+		if !strings.HasPrefix(aerr.Message, "Fast and reference results differ") || aerr.Diff == "" {
+			t.Errorf("unexpected message or diff: %q %q", aerr.Message, aerr.Diff)
+		}
+	})
+
+	t.Run("TwoResults", func(t *testing.T) {
+		atoi := func(s string) func() (int, error) {
+			return func() (int, error) { return strconv.Atoi(s) }
+		}
+		parse := func(s string) func() (int, error) {
+			return func() (int, error) {
+				if s == "" {
+					return 0, errors.New("empty")
+				}
+				return strconv.Atoi(s)
+			}
+		}
+
+		if v, err := assert.SameResult2(parse("42"), atoi("42")); v != 42 || err != nil {
+			t.Fatalf("unexpected results: %v %v", v, err)
+		}
+		requirePanics(t, func() {
+			assert.SameResult2(parse(""), atoi(""))
+		})
+	})
+
+	t.Run("Sampling", func(t *testing.T) {
+		prev := assert.SetSameResultSampling(3)
+		defer assert.SetSameResultSampling(prev)
+
+		calls := 0
+		for i := 0; i < 9; i++ {
+			assert.SameResult(
+				func() int { return 1 },
+				func() int { calls++; return 1 },
+			)
+		}
+		if calls != 3 {
+			t.Fatalf("expected reference to be called 3 times, got %d", calls)
+		}
+	})
+}
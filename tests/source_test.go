@@ -0,0 +1,62 @@
+//go:build assert
+
+package assert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+func TestAssertionExpression(t *testing.T) {
+	items := []int{1, 2}
+
+	t.Run("SingleLine", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			assert.True(len(items) <= 1)
+		})
+
+		if aerr.Expression != "assert.True(len(items) <= 1)" {
+			t.Errorf("unexpected expression: %q", aerr.Expression)
+		}
+		if !strings.Contains(aerr.Error(), "Expression:") {
+			t.Errorf("unexpected error report: %q", aerr.Error())
+		}
+	})
+
+	t.Run("MultiLine", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			assert.Equal(
+				len(items),
+				3,
+				"items must contain 3 elements",
+			)
+		})
+
+		if !strings.HasPrefix(aerr.Expression, "assert.Equal(\n") || !strings.HasSuffix(aerr.Expression, ")") {
+			t.Errorf("unexpected expression: %q", aerr.Expression)
+		}
+	})
+
+	t.Run("Generic", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			assert.As[string](any(len(items)))
+		})
+
+		if aerr.Expression != "assert.As[string](any(len(items)))" {
+			t.Errorf("unexpected expression: %q", aerr.Expression)
+		}
+	})
+
+	t.Run("Method", func(t *testing.T) {
+		var mu assert.Mutex
+		aerr := recoverAssertionError(t, func() {
+			mu.Unlock()
+		})
+
+		if aerr.Expression != "mu.Unlock()" {
+			t.Errorf("unexpected expression: %q", aerr.Expression)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+//go:build assert
+
+package assert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+type structTagAddress struct {
+	City string `assert:"nonzero"`
+}
+
+type structTagUser struct {
+	Name    string   `assert:"nonzero,len<=8"`
+	Age     int      `assert:"min=1,max=100"`
+	Score   float64  `assert:"max=1.5"`
+	Role    string   `assert:"oneof=admin user"`
+	Email   string   `assert:"regexp=^[a-z]+@[a-z]+\\.com$"`
+	Tags    []string `assert:"len!=0"`
+	Address *structTagAddress
+	Home    structTagAddress
+	ignored int
+}
+
+func TestAssertStruct(t *testing.T) {
+	valid := structTagUser{
+		Name:    "alice",
+		Age:     30,
+		Score:   1.5,
+		Role:    "admin",
+		Email:   "alice@example.com",
+		Tags:    []string{"a"},
+		Address: &structTagAddress{City: "Paris"},
+		Home:    structTagAddress{City: "Lyon"},
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		assert.Struct(valid)
+		assert.Struct(&valid)
+	})
+
+	t.Run("AllViolationsReported", func(t *testing.T) {
+		user := structTagUser{
+			Name:    "bartholomew",
+			Age:     0,
+			Score:   2,
+			Role:    "root",
+			Email:   "not an email",
+			Address: &structTagAddress{},
+		}
+
+		aerr := recoverAssertionError(t, func() {
+			assert.Struct(&user)
+		})
+
+		for _, field := range []string{"Name", "Age", "Score", "Role", "Email", "Tags", "Address.City", "Home.City"} {
+			if !strings.Contains(aerr.Message, "\n"+field+": ") {
+				t.Errorf("%v violation not reported: %q", field, aerr.Message)
+			}
+		}
+	})
+
+	t.Run("Cyclic", func(t *testing.T) {
+		type node struct {
+			Value int `assert:"min=1"`
+			Prev  *node
+			Next  *node
+		}
+
+		a := &node{Value: 1}
+		b := &node{Value: 0, Prev: a, Next: a}
+		a.Prev, a.Next = b, b
+
+		aerr := recoverAssertionError(t, func() {
+			assert.Struct(a)
+		})
+		if strings.Count(aerr.Message, "doesn't satisfy") != 1 || !strings.Contains(aerr.Message, "\nPrev.Value: ") {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+	})
+
+	t.Run("InvalidTag", func(t *testing.T) {
+		type invalid struct {
+			Age int `assert:"min=abc"`
+		}
+
+		aerr := recoverAssertionError(t, func() {
+			assert.Struct(invalid{})
+		})
+		if !strings.Contains(aerr.Message, "Invalid assert tag on field") {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+	})
+
+	t.Run("NotAStruct", func(t *testing.T) {
+		requirePanics(t, func() {
+			assert.Struct(42)
+		})
+	})
+}
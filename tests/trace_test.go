@@ -0,0 +1,161 @@
+//go:build assert
+
+package assert
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+func TestTraceOptions(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			assert.True(false)
+		})
+
+		last := aerr.Trace[len(aerr.Trace)-1]
+		if !filepath.IsAbs(last) || strings.ContainsAny(last, " \n") {
+			t.Errorf("unexpected trace entry: %q", last)
+		}
+	})
+
+	t.Run("Functions", func(t *testing.T) {
+		defer assert.SetTraceOptions(assert.SetTraceOptions(assert.TraceOptions{
+			Functions:     true,
+			RelativePaths: true,
+		}))
+
+		aerr := recoverAssertionError(t, func() {
+			assert.True(false)
+		})
+
+		last := aerr.Trace[len(aerr.Trace)-1]
+		if !strings.HasPrefix(last, "tests/trace_test.go:") || !strings.Contains(last, " tests.TestTraceOptions.func") {
+			t.Errorf("unexpected trace entry: %q", last)
+		}
+	})
+
+	t.Run("Context", func(t *testing.T) {
+		defer assert.SetTraceOptions(assert.SetTraceOptions(assert.TraceOptions{Context: 1}))
+
+		aerr := recoverAssertionError(t, func() {
+			assert.True(false)
+		})
+
+		var entry string
+		for _, e := range aerr.Trace {
+			if strings.Contains(e, "trace_test.go") {
+				entry = e
+				break
+			}
+		}
+
+		lines := strings.Split(entry, "\n")
+		if len(lines) != 4 || !strings.HasPrefix(lines[2], ">") || !strings.HasSuffix(lines[2], "| \t\t\tassert.True(false)") {
+			t.Errorf("unexpected trace entry: %q", entry)
+		}
+		if !strings.Contains(aerr.Error(), "\t"+lines[2]+"\n") {
+			t.Errorf("snippet missing from report: %q", aerr.Error())
+		}
+	})
+
+	t.Run("StdRelativePaths", func(t *testing.T) {
+		defer assert.SetTraceOptions(assert.SetTraceOptions(assert.TraceOptions{RelativePaths: true}))
+
+		aerr := recoverAssertionError(t, func() {
+			reflect.ValueOf(func() { assert.True(false) }).Call(nil)
+		})
+
+		found := false
+		for _, e := range aerr.Trace {
+			if strings.HasPrefix(e, "reflect/value.go:") {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("standard library frame not relative to GOROOT/src: %q", aerr.Trace)
+		}
+	})
+
+	t.Run("CollapseRuntime", func(t *testing.T) {
+		defer assert.SetTraceOptions(assert.SetTraceOptions(assert.TraceOptions{CollapseRuntime: true}))
+
+		aerr := recoverAssertionError(t, func() {
+			reflect.ValueOf(func() { assert.True(false) }).Call(nil)
+		})
+
+		collapsed := false
+		for _, e := range aerr.Trace {
+			if strings.Contains(e, "reflect") {
+				t.Errorf("unexpected standard library frame: %q", e)
+			}
+			if strings.HasPrefix(e, "... ") && strings.HasSuffix(e, " standard library frames") {
+				collapsed = true
+			}
+		}
+		if !collapsed {
+			t.Errorf("standard library frames not collapsed: %q", aerr.Trace)
+		}
+	})
+}
+
+func requireEven(n int) {
+	assert.Helper()
+	assert.Equal(0, n%2)
+}
+
+func TestHelper(t *testing.T) {
+	aerr := recoverAssertionError(t, func() {
+		requireEven(3)
+	})
+
+	for _, e := range aerr.Trace {
+		if !strings.Contains(e, string(filepath.Separator)+"tests"+string(filepath.Separator)) {
+			t.Errorf("unexpected frame outside of tests package: %q", e)
+		}
+	}
+
+	// Trace and expression start at the call to the helper.
+	defer assert.SetTraceOptions(assert.SetTraceOptions(assert.TraceOptions{Functions: true}))
+	aerr = recoverAssertionError(t, func() {
+		requireEven(3)
+	})
+	if !strings.HasSuffix(aerr.Trace[0], " tests.TestHelper.func2") {
+		t.Errorf("unexpected first frame: %q", aerr.Trace)
+	}
+	if aerr.Assertion != "Equal" {
+		t.Errorf("unexpected assertion: %q", aerr.Assertion)
+	}
+	if aerr.Expression != "requireEven(3)" {
+		t.Errorf("unexpected expression: %q", aerr.Expression)
+	}
+}
+
+func recurse(depth int, cb func()) {
+	if depth == 0 {
+		cb()
+		return
+	}
+	recurse(depth-1, cb)
+}
+
+func TestTraceDeepStack(t *testing.T) {
+	defer assert.SetTraceOptions(assert.SetTraceOptions(assert.TraceOptions{Functions: true}))
+
+	aerr := recoverAssertionError(t, func() {
+		recurse(100, func() {
+			assert.True(false)
+		})
+	})
+
+	if len(aerr.Trace) < 100 {
+		t.Errorf("expected at least 100 frames, got %d", len(aerr.Trace))
+	}
+	if last := aerr.Trace[len(aerr.Trace)-1]; !strings.HasSuffix(last, " tests.TestTraceDeepStack") {
+		t.Errorf("unexpected last frame: %q", last)
+	}
+}
@@ -0,0 +1,89 @@
+//go:build assert
+
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+type typeAssertError struct{}
+
+func (*typeAssertError) Error() string { return "error" }
+
+func TestAssertAs(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		if assert.As[int](any(42)) != 42 {
+			t.Fatal("unexpected value")
+		}
+		if assert.As[fmt.Stringer](any(&typeAssertStringer{})) == nil {
+			t.Fatal("unexpected nil")
+		}
+	})
+
+	t.Run("WrongType", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			assert.As[string](any(42))
+		})
+		if aerr.Message != "Type assertion failed: expected string, got int" {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		requirePanics(t, func() {
+			assert.As[error](nil)
+		})
+	})
+
+	t.Run("TypedNil", func(t *testing.T) {
+		var ptr *typeAssertError
+		aerr := recoverAssertionError(t, func() {
+			assert.As[error](any(ptr))
+		})
+		if aerr.Message != "Expected non-nil error, got typed nil *assert.typeAssertError" {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+	})
+}
+
+type typeAssertStringer struct{}
+
+func (*typeAssertStringer) String() string { return "" }
+
+func TestAssertDeref(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		v := 42
+		if assert.Deref(&v) != 42 {
+			t.Fatal("unexpected value")
+		}
+
+		var err error
+		if assert.Deref(&err) != nil {
+			t.Fatal("unexpected error")
+		}
+		err = errors.New("error")
+		assert.Deref(&err)
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			var p *int
+			assert.Deref(p)
+		})
+		if aerr.Message != "Nil pointer dereference of *int" {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+	})
+
+	t.Run("TypedNil", func(t *testing.T) {
+		var ptr *typeAssertError
+		var err error = ptr
+		requirePanics(t, func() {
+			assert.Deref(&err)
+		})
+	})
+}
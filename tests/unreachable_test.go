@@ -0,0 +1,42 @@
+//go:build assert
+
+package assert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/negrel/assert"
+)
+
+func TestAssertUnreachable(t *testing.T) {
+	type color int
+
+	for _, test := range []struct {
+		kind string
+		cb   func()
+	}{
+		{"Unreachable", func() { assert.Unreachable() }},
+		{"Todo", func() { assert.Todof("support %s", "IPv6") }},
+		{"Unknown enum", func() { assert.UnknownEnum(color(42)) }},
+	} {
+		t.Run(test.kind, func(t *testing.T) {
+			aerr := recoverAssertionError(t, test.cb)
+			if aerr.Kind != test.kind {
+				t.Errorf("unexpected kind: %q", aerr.Kind)
+			}
+			if !strings.Contains(aerr.Error(), test.kind+":") {
+				t.Errorf("unexpected error report: %q", aerr.Error())
+			}
+		})
+	}
+
+	t.Run("UnknownEnumMessage", func(t *testing.T) {
+		aerr := recoverAssertionError(t, func() {
+			assert.UnknownEnum(color(42))
+		})
+		if aerr.Message != "Unexpected assert.color value: 42" {
+			t.Errorf("unexpected message: %q", aerr.Message)
+		}
+	})
+}
@@ -0,0 +1,233 @@
+//go:build assert
+
+package assert
+
+import (
+	"bytes"
+	"fmt"
+	"go/build"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// TraceOptions controls how caller frames are rendered in the "Error Trace"
+// section of failure reports and in [AssertionError.Trace]. The zero
+// TraceOptions, which is the default, renders frames as absolute
+// "file:line" strings like [CallerInfo].
+type TraceOptions struct {
+	// Functions appends the function name to each frame.
+	Functions bool
+	// Context is the number of source lines shown before and after the line
+	// of each frame. The line of the frame is marked with ">". Zero disables
+	// source snippets.
+	Context int
+	// RelativePaths renders paths relative to the root of their module (the
+	// directory containing go.mod) and standard library paths relative to
+	// GOROOT/src.
+	RelativePaths bool
+	// CollapseRuntime replaces consecutive standard library frames (runtime,
+	// reflect, sync...) by a single line.
+	CollapseRuntime bool
+}
+
+var traceOptions atomic.Pointer[TraceOptions]
+
+// SetTraceOptions sets the options used to render caller frames of failure
+// reports and returns the previous ones.
+//
+//	assert.SetTraceOptions(assert.TraceOptions{
+//		Functions:       true,
+//		Context:         2,
+//		RelativePaths:   true,
+//		CollapseRuntime: true,
+//	})
+func SetTraceOptions(o TraceOptions) TraceOptions {
+	prev := traceOptions.Swap(&o)
+	if prev == nil {
+		return TraceOptions{}
+	}
+	return *prev
+}
+
+// Helper marks the calling function as a helper function: its frames are
+// omitted from [CallerInfo] and from the "Error Trace" of failure reports,
+// like frames of this package, and [AssertionError.Expression] is the call to
+// the outermost helper. Helper may be called concurrently and repeatedly from
+// the same function.
+//
+//	func requireValidUser(u *User) {
+//		assert.Helper()
+//		assert.NotEmpty(u.Name)
+//		assert.Positive(u.Age)
+//	}
+func Helper() {
+	var pc [1]uintptr
+	if runtime.Callers(2, pc[:]) == 0 {
+		return
+	}
+
+	frame, _ := runtime.CallersFrames(pc[:]).Next()
+	helperFuncs.LoadOrStore(frame.Function, struct{}{})
+}
+
+// callerTrace returns the caller frames leading to the failed assertion,
+// rendered according to the current trace options.
+func callerTrace() []string {
+	var opts TraceOptions
+	if o := traceOptions.Load(); o != nil {
+		opts = *o
+	}
+
+	return renderTrace(callerFrames(), opts)
+}
+
+// renderTrace renders frames according to opts. Each returned entry may span
+// multiple lines if opts.Context is set.
+func renderTrace(frames []runtime.Frame, opts TraceOptions) []string {
+	trace := make([]string, 0, len(frames))
+	for i := 0; i < len(frames); i++ {
+		if opts.CollapseRuntime && isStdFrame(frames[i]) {
+			n := 1
+			for i+n < len(frames) && isStdFrame(frames[i+n]) {
+				n++
+			}
+			if n > 1 {
+				trace = append(trace, fmt.Sprintf("... %d standard library frames", n))
+				i += n - 1
+				continue
+			}
+		}
+
+		trace = append(trace, renderFrame(frames[i], opts))
+	}
+
+	return trace
+}
+
+// renderFrame renders a single frame according to opts.
+func renderFrame(frame runtime.Frame, opts TraceOptions) string {
+	var b strings.Builder
+
+	file := frame.File
+	if opts.RelativePaths {
+		file = relativePath(frame)
+	}
+	fmt.Fprintf(&b, "%s:%d", file, frame.Line)
+
+	if opts.Functions && frame.Function != "" {
+		b.WriteString(" ")
+		b.WriteString(shortFuncName(frame.Function))
+	}
+
+	if opts.Context > 0 {
+		writeSnippet(&b, frame, opts.Context)
+	}
+
+	return b.String()
+}
+
+// writeSnippet writes the source lines surrounding the line of frame, if the
+// source file is available.
+func writeSnippet(b *strings.Builder, frame runtime.Frame, context int) {
+	src, ok := readSource(frame.File)
+	if !ok {
+		return
+	}
+
+	lines := bytes.Split(src, []byte("\n"))
+	if frame.Line < 1 || frame.Line > len(lines) {
+		return
+	}
+
+	first := max(frame.Line-context, 1)
+	last := min(frame.Line+context, len(lines))
+	width := len(fmt.Sprint(last))
+	for n := first; n <= last; n++ {
+		marker := " "
+		if n == frame.Line {
+			marker = ">"
+		}
+		line := strings.TrimRight(string(lines[n-1]), " \t\r")
+		fmt.Fprintf(b, "\n%s %*d | %s", marker, width, n, line)
+	}
+}
+
+// readSource returns the content of the source file at path. Parsed files
+// are reused, other files (e.g. files with syntax errors) are read directly.
+func readSource(path string) ([]byte, bool) {
+	if sf := loadSourceFile(path); sf != nil {
+		return sf.src, true
+	}
+
+	src, err := os.ReadFile(path)
+	return src, err == nil
+}
+
+// shortFuncName strips the import path directories of a fully qualified
+// function name (e.g. "github.com/x/y.(*T).M" becomes "y.(*T).M").
+func shortFuncName(name string) string {
+	if i := strings.LastIndexByte(name, '/'); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// gorootSrc is the slash-separated GOROOT/src directory, with a trailing
+// slash, or an empty string if GOROOT is unknown.
+var gorootSrc = func() string {
+	if build.Default.GOROOT == "" {
+		return ""
+	}
+	return strings.TrimSuffix(filepath.ToSlash(build.Default.GOROOT), "/") + "/src/"
+}()
+
+// isStdFrame reports whether frame belongs to the standard library, that is
+// whether its source file is located in GOROOT/src.
+func isStdFrame(frame runtime.Frame) bool {
+	return gorootSrc != "" && strings.HasPrefix(filepath.ToSlash(frame.File), gorootSrc)
+}
+
+// relativePath returns the path of the source file of frame relative to its
+// module root or to GOROOT/src for standard library files. The absolute path
+// is returned if neither can be found.
+func relativePath(frame runtime.Frame) string {
+	if isStdFrame(frame) {
+		return strings.TrimPrefix(filepath.ToSlash(frame.File), gorootSrc)
+	}
+
+	root := moduleRoot(filepath.Dir(frame.File))
+	if root == "" {
+		return frame.File
+	}
+	rel, err := filepath.Rel(root, frame.File)
+	if err != nil {
+		return frame.File
+	}
+
+	return filepath.ToSlash(rel)
+}
+
+// moduleRoots caches module root directories by source directory.
+var moduleRoots sync.Map
+
+// moduleRoot returns the closest parent directory of dir (including dir)
+// containing a go.mod file or an empty string.
+func moduleRoot(dir string) string {
+	if root, ok := moduleRoots.Load(dir); ok {
+		return root.(string)
+	}
+
+	root := ""
+	if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+		root = dir
+	} else if parent := filepath.Dir(dir); parent != dir {
+		root = moduleRoot(parent)
+	}
+
+	moduleRoots.Store(dir, root)
+	return root
+}
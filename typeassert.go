@@ -0,0 +1,39 @@
+//go:build assert
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// As returns v.(T). It asserts that v holds a value of type T and reports its
+// dynamic type otherwise. If T is an interface type, As also asserts that v
+// isn't a typed nil (e.g. a nil *MyError stored in an error).
+//
+//	err := assert.As[*MyError](err)
+func As[T any](v any) T {
+	t, ok := v.(T)
+	if !ok {
+		Fail(fmt.Sprintf("Type assertion failed: expected %v, got %T", reflect.TypeFor[T](), v))
+	} else if reflect.TypeFor[T]().Kind() == reflect.Interface && isNil(v) {
+		Fail(fmt.Sprintf("Expected non-nil %v, got typed nil %T", reflect.TypeFor[T](), v))
+	}
+
+	return t
+}
+
+// Deref returns *p. It asserts that p isn't nil. If T is an interface type,
+// Deref also asserts that *p isn't a typed nil (e.g. a nil *MyError stored in
+// an error).
+//
+//	cfg := assert.Deref(cfgPtr)
+func Deref[T any](p *T) T {
+	if p == nil {
+		Fail(fmt.Sprintf("Nil pointer dereference of %T", p))
+	} else if v := any(*p); v != nil && reflect.TypeFor[T]().Kind() == reflect.Interface && isNil(v) {
+		Fail(fmt.Sprintf("Dereferenced %v holds a typed nil %T", reflect.TypeFor[T](), v))
+	}
+
+	return *p
+}
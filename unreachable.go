@@ -0,0 +1,63 @@
+//go:build assert
+
+package assert
+
+import "fmt"
+
+// Unreachable reports that code that must never execute was reached. Its
+// failures are labeled "Unreachable" in the report.
+//
+//	assert.Unreachable("handled by caller")
+func Unreachable(msgAndArgs ...interface{}) bool {
+	return failWith(&AssertionError{Kind: "Unreachable", Message: "Reached code marked as unreachable"}, msgAndArgs...)
+}
+
+// Unreachablef reports that code that must never execute was reached. Its
+// failures are labeled "Unreachable" in the report.
+//
+//	assert.Unreachablef("error message %s", "formatted")
+func Unreachablef(msg string, args ...interface{}) bool {
+	return Unreachable(append([]interface{}{msg}, args...)...)
+}
+
+// Todo reports that code that isn't implemented yet was reached. Its failures
+// are labeled "Todo" in the report.
+//
+//	assert.Todo("support IPv6")
+func Todo(msgAndArgs ...interface{}) bool {
+	return failWith(&AssertionError{Kind: "Todo", Message: "Reached code that isn't implemented yet"}, msgAndArgs...)
+}
+
+// Todof reports that code that isn't implemented yet was reached. Its
+// failures are labeled "Todo" in the report.
+//
+//	assert.Todof("error message %s", "formatted")
+func Todof(msg string, args ...interface{}) bool {
+	return Todo(append([]interface{}{msg}, args...)...)
+}
+
+// UnknownEnum reports that v isn't a known value of its enum type. It is meant
+// to be called in the default case of switches over enums. Its failures are
+// labeled "Unknown enum" in the report.
+//
+//	switch color {
+//	case Red, Green, Blue:
+//		// ...
+//	default:
+//		assert.UnknownEnum(color)
+//	}
+func UnknownEnum(v interface{}, msgAndArgs ...interface{}) bool {
+	return failWith(&AssertionError{
+		Kind:    "Unknown enum",
+		Message: fmt.Sprintf("Unexpected %T value: %#v", v, v),
+		Actual:  v,
+	}, msgAndArgs...)
+}
+
+// UnknownEnumf reports that v isn't a known value of its enum type. See
+// [UnknownEnum].
+//
+//	assert.UnknownEnumf(color, "error message %s", "formatted")
+func UnknownEnumf(v interface{}, msg string, args ...interface{}) bool {
+	return UnknownEnum(v, append([]interface{}{msg}, args...)...)
+}